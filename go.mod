@@ -14,6 +14,11 @@
 
 module github.com/gomem/gomem
 
-go 1.14
+go 1.23
 
 require github.com/apache/arrow/go/arrow v0.0.0-20200711183337-7b49cbc23f22
+
+require (
+	github.com/google/flatbuffers v1.11.0 // indirect
+	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543 // indirect
+)
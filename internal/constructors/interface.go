@@ -20,6 +20,7 @@ import (
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/gomem/gomem/internal/cast"
 	"fmt"
+	"reflect"
 )
 
 // NewInterfaceFromMem builds a new column from memory
@@ -151,13 +152,24 @@ func NewInterfaceFromMem(mem memory.Allocator, name string, values interface{},
 		}
 		return NewInterfaceFromMem(mem, name, ifaceDense, validDense)
 
-	// TODO(nickpoorman): Handle reflect.Map, and reflect.Struct
-
 	default:
+		if rv := reflect.ValueOf(values); rv.Kind() == reflect.Slice {
+			elem := rv.Type().Elem()
+			if elem.Kind() == reflect.Map {
+				return newMapFromMem(mem, name, rv, valid)
+			}
+			if elem.Kind() == reflect.Ptr {
+				elem = elem.Elem()
+			}
+			if elem.Kind() == reflect.Struct {
+				return newStructFromMem(mem, name, rv, valid)
+			}
+		}
 		err := fmt.Errorf("dataframe/interface: invalid data type for %q (%T)", name, v)
 		return nil, nil, err
 	}
 
-	field := &arrow.Field{Name: name, Type: arr.DataType()}
+	// Columns holding nulls must be nullable for NewDataFrame to accept them.
+	field := &arrow.Field{Name: name, Type: arr.DataType(), Nullable: arr.NullN() > 0}
 	return arr, field, nil
 }
@@ -0,0 +1,78 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constructors
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/smartbuilder"
+)
+
+// newMapFromMem builds a new map column from a slice of Go maps, ie. []map[string]T.
+// nil maps are appended as null.
+func newMapFromMem(mem memory.Allocator, name string, values reflect.Value, valid []bool) (array.Interface, *arrow.Field, error) {
+	mapType := values.Type().Elem()
+	key, err := smartbuilder.FieldOf("key", mapType.Key())
+	if err != nil {
+		return nil, nil, fmt.Errorf("dataframe/interface: invalid map key type for %q: %w", name, err)
+	}
+
+	valueGoType := mapType.Elem()
+	if valueGoType.Kind() == reflect.Interface {
+		// Use the type of the first value that is not nil.
+		valueGoType = nil
+		for i := 0; i < values.Len() && valueGoType == nil; i++ {
+			iter := values.Index(i).MapRange()
+			for iter.Next() {
+				if !iter.Value().IsNil() {
+					valueGoType = iter.Value().Elem().Type()
+					break
+				}
+			}
+		}
+		if valueGoType == nil {
+			return nil, nil, fmt.Errorf("dataframe/interface: cannot infer map value type for %q", name)
+		}
+	}
+	value, err := smartbuilder.FieldOf("value", valueGoType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dataframe/interface: invalid map value type for %q: %w", name, err)
+	}
+
+	field := logical.MapField(name, key.Type, value.Type)
+	schema := arrow.NewSchema([]arrow.Field{field}, nil)
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+
+	sb := smartbuilder.NewSmartBuilder(rb)
+	for i := 0; i < values.Len(); i++ {
+		v := values.Index(i)
+		if len(valid) > 0 && !valid[i] {
+			sb.Append(0, nil)
+			continue
+		}
+		if err := sb.Append(0, v.Interface()); err != nil {
+			return nil, nil, fmt.Errorf("dataframe/interface: %q: %w", name, err)
+		}
+	}
+
+	arr := rb.Field(0).NewArray()
+	return arr, &field, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constructors
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/smartbuilder"
+)
+
+// newStructFromMem builds a new struct column from a slice of Go structs, ie. []T or []*T.
+// nil pointers are appended as null. A slice of time.Time becomes a timestamp column.
+func newStructFromMem(mem memory.Allocator, name string, values reflect.Value, valid []bool) (array.Interface, *arrow.Field, error) {
+	field, err := smartbuilder.FieldOf(name, values.Type().Elem())
+	if err != nil {
+		return nil, nil, fmt.Errorf("dataframe/interface: invalid struct type for %q: %w", name, err)
+	}
+	field.Nullable = true
+
+	schema := arrow.NewSchema([]arrow.Field{field}, nil)
+	rb := array.NewRecordBuilder(mem, schema)
+	defer rb.Release()
+
+	sb := smartbuilder.NewSmartBuilder(rb)
+	for i := 0; i < values.Len(); i++ {
+		if len(valid) > 0 && !valid[i] {
+			sb.Append(0, nil)
+			continue
+		}
+		if err := sb.Append(0, values.Index(i).Interface()); err != nil {
+			return nil, nil, fmt.Errorf("dataframe/interface: %q: %w", name, err)
+		}
+	}
+
+	arr := rb.Field(0).NewArray()
+	return arr, &field, nil
+}
@@ -13,7 +13,8 @@
 // limitations under the License.
 
 /*
-Package debug provides compiled assertions, debug and warn level logging.
+Package debug provides compiled assertions, debug and warn level logging
+and tracking of reference counts.
 
 To enable runtime debug or warn level logging, build with the debug or warn tags
 respectively. Building with the debug tag will enable the warn level logger automatically.
@@ -22,5 +23,9 @@ the binary.
 
 To enable runtime assertions, build with the assert tag. When the assert tag is omitted,
 the code for the assertions will be ommitted from the binary.
+
+Building with the debug tag also records the call sites that create, retain and release
+reference counted objects such as DataFrames and chunk iterators. LiveRefs reports the
+objects that still have references, which helps to find a missing Release.
 */
 package debug
@@ -0,0 +1,40 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !debug
+
+package debug
+
+import (
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// RefTracker records the call sites that changed the reference counts of the objects
+// holding memory from one allocator. It only records them when built with the debug tag.
+type RefTracker struct{}
+
+// NewRefTracker registers a RefTracker for the objects holding memory that owns reports as allocated.
+func NewRefTracker(owns func(addr uintptr) bool) *RefTracker { return nil }
+
+// Close stops tracking new objects and forgets the objects that were tracked.
+func (t *RefTracker) Close() {}
+
+// LiveRefs returns the objects that still have references along with the call sites that changed them.
+func (t *RefTracker) LiveRefs() []string { return nil }
+
+// TrackNewRef starts tracking obj, which was just created with a reference count of 1 and holds the columns.
+func TrackNewRef(obj interface{}, cols ...array.Column) {}
+
+// TrackRef records the call site of a change to the reference count of obj.
+func TrackRef(obj interface{}, count int64) {}
@@ -0,0 +1,214 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build debug
+// +build debug
+
+package debug
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+const maxRefFrames = 8
+
+// RefTracker records the call sites that changed the reference counts of the objects
+// holding memory from one allocator, so a leak can be traced back to a missing Release.
+type RefTracker struct {
+	owns func(addr uintptr) bool
+
+	mu    sync.Mutex
+	sites map[interface{}][]string
+}
+
+// trackers holds the registered RefTrackers and the tracker of each object with references.
+var trackers = struct {
+	sync.Mutex
+	list  []*RefTracker
+	byObj map[interface{}]*RefTracker
+}{
+	byObj: make(map[interface{}]*RefTracker),
+}
+
+// NewRefTracker registers a RefTracker for the objects holding memory that owns reports as allocated.
+// Close must be called once it is no longer needed.
+func NewRefTracker(owns func(addr uintptr) bool) *RefTracker {
+	t := &RefTracker{
+		owns:  owns,
+		sites: make(map[interface{}][]string),
+	}
+	trackers.Lock()
+	defer trackers.Unlock()
+	trackers.list = append(trackers.list, t)
+	return t
+}
+
+// Close stops tracking new objects and forgets the objects that were tracked.
+// It does nothing for a nil RefTracker.
+func (t *RefTracker) Close() {
+	if t == nil {
+		return
+	}
+	trackers.Lock()
+	defer trackers.Unlock()
+	for i, other := range trackers.list {
+		if other == t {
+			trackers.list = append(trackers.list[:i], trackers.list[i+1:]...)
+			break
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for obj := range t.sites {
+		delete(trackers.byObj, obj)
+	}
+	t.sites = nil
+}
+
+// LiveRefs returns the objects that still have references along with the call sites that changed them.
+// A nil RefTracker has none.
+func (t *RefTracker) LiveRefs() []string {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	live := make([]string, 0, len(t.sites))
+	for obj, sites := range t.sites {
+		live = append(live, fmt.Sprintf("%T %p:\n%s", obj, obj, strings.Join(sites, "")))
+	}
+	sort.Strings(live)
+	return live
+}
+
+func (t *RefTracker) record(obj interface{}, count int64, site string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.sites == nil {
+		return
+	}
+	if count <= 0 {
+		delete(t.sites, obj)
+		return
+	}
+	t.sites[obj] = append(t.sites[obj], fmt.Sprintf("refs=%d\n%s", count, site))
+}
+
+// TrackNewRef starts tracking obj, which was just created with a reference count of 1 and holds the columns.
+// obj is tracked by the RefTracker of the allocator the memory of the columns comes from, if there is one.
+func TrackNewRef(obj interface{}, cols ...array.Column) {
+	addr, ok := columnsAddr(cols)
+	if !ok {
+		return
+	}
+
+	trackers.Lock()
+	var tracker *RefTracker
+	for _, t := range trackers.list {
+		if t.owns(addr) {
+			tracker = t
+			break
+		}
+	}
+	if tracker != nil {
+		trackers.byObj[obj] = tracker
+	}
+	trackers.Unlock()
+
+	if tracker != nil {
+		// Skip TrackNewRef and the function that created obj.
+		tracker.record(obj, 1, callers(2, maxRefFrames))
+	}
+}
+
+// TrackRef records the call site of a change to the reference count of obj.
+// It should be called by Retain and Release with the new count, obj is forgotten once it reaches 0.
+// Only the objects given to TrackNewRef are tracked.
+func TrackRef(obj interface{}, count int64) {
+	trackers.Lock()
+	tracker, ok := trackers.byObj[obj]
+	if ok && count <= 0 {
+		delete(trackers.byObj, obj)
+	}
+	trackers.Unlock()
+
+	if ok {
+		// Skip TrackRef and the function that changed the count.
+		tracker.record(obj, count, callers(2, maxRefFrames))
+	}
+}
+
+// columnsAddr returns the address of the first non-empty buffer of the columns.
+func columnsAddr(cols []array.Column) (uintptr, bool) {
+	for i := range cols {
+		for _, chunk := range cols[i].Data().Chunks() {
+			if addr, ok := arrayAddr(chunk); ok {
+				return addr, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// arrayAddr returns the address of the first non-empty buffer of arr or of it's children.
+func arrayAddr(arr array.Interface) (uintptr, bool) {
+	for _, buf := range arr.Data().Buffers() {
+		if buf != nil && buf.Len() > 0 {
+			return uintptr(unsafe.Pointer(&buf.Buf()[0])), true
+		}
+	}
+	switch arr := arr.(type) {
+	case *array.List:
+		return arrayAddr(arr.ListValues())
+	case *array.FixedSizeList:
+		return arrayAddr(arr.ListValues())
+	case *array.Struct:
+		for i := 0; i < arr.NumField(); i++ {
+			if addr, ok := arrayAddr(arr.Field(i)); ok {
+				return addr, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// callers formats up to max frames of the calling goroutine's stack,
+// skipping skip frames above the caller of callers.
+func callers(skip, max int) string {
+	pcs := make([]uintptr, max)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var b strings.Builder
+	for {
+		frame, more := frames.Next()
+		if frame.Function == "testing.tRunner" {
+			break
+		}
+		fmt.Fprintf(&b, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,89 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build debug
+
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func newColumn(t *testing.T) (*array.Column, uintptr) {
+	b := array.NewInt64Builder(memory.NewGoAllocator())
+	defer b.Release()
+	b.AppendValues([]int64{1, 2, 3}, nil)
+	arr := b.NewInt64Array()
+	defer arr.Release()
+
+	chunked := array.NewChunked(arrow.PrimitiveTypes.Int64, []array.Interface{arr})
+	defer chunked.Release()
+
+	addr, ok := arrayAddr(arr)
+	if !ok {
+		t.Fatal("expected the array to have a buffer")
+	}
+	return array.NewColumn(arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64}, chunked), addr
+}
+
+func TestRefTracker(t *testing.T) {
+	col, addr := newColumn(t)
+	defer col.Release()
+	other, _ := newColumn(t)
+	defer other.Release()
+
+	tracker := NewRefTracker(func(a uintptr) bool { return a == addr })
+	defer tracker.Close()
+	unrelated := NewRefTracker(func(uintptr) bool { return false })
+	defer unrelated.Close()
+
+	type object struct{ _ int }
+	obj := &object{}
+	untracked := &object{}
+	TrackNewRef(obj, *col)
+	TrackNewRef(untracked, *other)
+	TrackRef(obj, 2)
+
+	live := tracker.LiveRefs()
+	if got, want := len(live), 1; got != want {
+		t.Fatalf("got=%d, want=%d: %v", got, want, live)
+	}
+	if got, want := live[0], "refs=2"; !strings.Contains(got, want) {
+		t.Fatalf("got=\n%v\nwant it to contain %q", got, want)
+	}
+	if got := unrelated.LiveRefs(); len(got) != 0 {
+		t.Fatalf("got=%v, want no live refs for another tracker", got)
+	}
+
+	TrackRef(obj, 0)
+	if got := tracker.LiveRefs(); len(got) != 0 {
+		t.Fatalf("got=%v, want no live refs once released", got)
+	}
+
+	// Closing a tracker forgets the objects it still had.
+	leaked := &object{}
+	TrackNewRef(leaked, *col)
+	tracker.Close()
+	trackers.Lock()
+	_, ok := trackers.byObj[leaked]
+	trackers.Unlock()
+	if ok {
+		t.Fatal("expected the leaked object to be forgotten")
+	}
+}
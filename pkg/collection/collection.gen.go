@@ -19,25 +19,122 @@ package collection
 import (
 	"fmt"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/gomem/gomem/pkg/object"
 )
 
 // NewBooleanCollection creates a new Boolean collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewBooleanCollectionWithValues for that.
 func NewBooleanCollection(builder *array.BooleanBuilder) *BooleanCollection {
 	return &BooleanCollection{
 		builder: builder,
 	}
 }
 
+// NewBooleanCollectionWithValues creates a new Boolean collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewBooleanCollectionWithValues(builder *array.BooleanBuilder) *BooleanCollection {
+	return &BooleanCollection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewBooleanCollectionFromArray creates a new read only Boolean collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewBooleanCollectionFromArray(arr *array.Boolean) *BooleanCollection {
+	return &BooleanCollection{
+		arr: arr,
+	}
+}
+
 // BooleanCollection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type BooleanCollection struct {
+	arr     *array.Boolean
 	builder *array.BooleanBuilder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []bool
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *BooleanCollection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *BooleanCollection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *BooleanCollection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Boolean collection builder can not be read back, use NewBooleanCollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *BooleanCollection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *BooleanCollection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewBoolean(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewBoolean(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *BooleanCollection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *BooleanCollection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *BooleanCollection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Boolean collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero bool
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -47,24 +144,128 @@ func (c *BooleanCollection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *BooleanCollection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewDate32Collection creates a new Date32 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewDate32CollectionWithValues for that.
 func NewDate32Collection(builder *array.Date32Builder) *Date32Collection {
 	return &Date32Collection{
 		builder: builder,
 	}
 }
 
+// NewDate32CollectionWithValues creates a new Date32 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewDate32CollectionWithValues(builder *array.Date32Builder) *Date32Collection {
+	return &Date32Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewDate32CollectionFromArray creates a new read only Date32 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewDate32CollectionFromArray(arr *array.Date32) *Date32Collection {
+	return &Date32Collection{
+		arr: arr,
+	}
+}
+
 // Date32Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Date32Collection struct {
+	arr     *array.Date32
 	builder *array.Date32Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []arrow.Date32
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Date32Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Date32Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Date32Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Date32 collection builder can not be read back, use NewDate32CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Date32Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Date32Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewDate32(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewDate32(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Date32Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Date32Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Date32Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Date32 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero arrow.Date32
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -74,24 +275,128 @@ func (c *Date32Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Date32Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewDate64Collection creates a new Date64 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewDate64CollectionWithValues for that.
 func NewDate64Collection(builder *array.Date64Builder) *Date64Collection {
 	return &Date64Collection{
 		builder: builder,
 	}
 }
 
+// NewDate64CollectionWithValues creates a new Date64 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewDate64CollectionWithValues(builder *array.Date64Builder) *Date64Collection {
+	return &Date64Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewDate64CollectionFromArray creates a new read only Date64 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewDate64CollectionFromArray(arr *array.Date64) *Date64Collection {
+	return &Date64Collection{
+		arr: arr,
+	}
+}
+
 // Date64Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Date64Collection struct {
+	arr     *array.Date64
 	builder *array.Date64Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []arrow.Date64
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Date64Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Date64Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Date64Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Date64 collection builder can not be read back, use NewDate64CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Date64Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Date64Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewDate64(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewDate64(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Date64Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Date64Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Date64Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Date64 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero arrow.Date64
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -101,24 +406,128 @@ func (c *Date64Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Date64Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewDayTimeIntervalCollection creates a new DayTimeInterval collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewDayTimeIntervalCollectionWithValues for that.
 func NewDayTimeIntervalCollection(builder *array.DayTimeIntervalBuilder) *DayTimeIntervalCollection {
 	return &DayTimeIntervalCollection{
 		builder: builder,
 	}
 }
 
+// NewDayTimeIntervalCollectionWithValues creates a new DayTimeInterval collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewDayTimeIntervalCollectionWithValues(builder *array.DayTimeIntervalBuilder) *DayTimeIntervalCollection {
+	return &DayTimeIntervalCollection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewDayTimeIntervalCollectionFromArray creates a new read only DayTimeInterval collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewDayTimeIntervalCollectionFromArray(arr *array.DayTimeInterval) *DayTimeIntervalCollection {
+	return &DayTimeIntervalCollection{
+		arr: arr,
+	}
+}
+
 // DayTimeIntervalCollection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type DayTimeIntervalCollection struct {
+	arr     *array.DayTimeInterval
 	builder *array.DayTimeIntervalBuilder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []arrow.DayTimeInterval
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *DayTimeIntervalCollection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *DayTimeIntervalCollection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *DayTimeIntervalCollection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the DayTimeInterval collection builder can not be read back, use NewDayTimeIntervalCollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *DayTimeIntervalCollection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *DayTimeIntervalCollection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewDayTimeInterval(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewDayTimeInterval(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *DayTimeIntervalCollection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *DayTimeIntervalCollection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *DayTimeIntervalCollection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only DayTimeInterval collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero arrow.DayTimeInterval
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -128,24 +537,128 @@ func (c *DayTimeIntervalCollection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *DayTimeIntervalCollection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewDecimal128Collection creates a new Decimal128 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewDecimal128CollectionWithValues for that.
 func NewDecimal128Collection(builder *array.Decimal128Builder) *Decimal128Collection {
 	return &Decimal128Collection{
 		builder: builder,
 	}
 }
 
+// NewDecimal128CollectionWithValues creates a new Decimal128 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewDecimal128CollectionWithValues(builder *array.Decimal128Builder) *Decimal128Collection {
+	return &Decimal128Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewDecimal128CollectionFromArray creates a new read only Decimal128 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewDecimal128CollectionFromArray(arr *array.Decimal128) *Decimal128Collection {
+	return &Decimal128Collection{
+		arr: arr,
+	}
+}
+
 // Decimal128Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Decimal128Collection struct {
+	arr     *array.Decimal128
 	builder *array.Decimal128Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []decimal128.Num
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Decimal128Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Decimal128Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Decimal128Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Decimal128 collection builder can not be read back, use NewDecimal128CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Decimal128Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Decimal128Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewDecimal128(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewDecimal128(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Decimal128Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Decimal128Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Decimal128Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Decimal128 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero decimal128.Num
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -155,24 +668,128 @@ func (c *Decimal128Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Decimal128Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewDurationCollection creates a new Duration collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewDurationCollectionWithValues for that.
 func NewDurationCollection(builder *array.DurationBuilder) *DurationCollection {
 	return &DurationCollection{
 		builder: builder,
 	}
 }
 
+// NewDurationCollectionWithValues creates a new Duration collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewDurationCollectionWithValues(builder *array.DurationBuilder) *DurationCollection {
+	return &DurationCollection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewDurationCollectionFromArray creates a new read only Duration collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewDurationCollectionFromArray(arr *array.Duration) *DurationCollection {
+	return &DurationCollection{
+		arr: arr,
+	}
+}
+
 // DurationCollection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type DurationCollection struct {
+	arr     *array.Duration
 	builder *array.DurationBuilder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []arrow.Duration
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *DurationCollection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *DurationCollection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *DurationCollection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Duration collection builder can not be read back, use NewDurationCollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *DurationCollection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *DurationCollection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewDuration(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewDuration(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *DurationCollection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *DurationCollection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *DurationCollection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Duration collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero arrow.Duration
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -182,24 +799,128 @@ func (c *DurationCollection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *DurationCollection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewFloat16Collection creates a new Float16 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewFloat16CollectionWithValues for that.
 func NewFloat16Collection(builder *array.Float16Builder) *Float16Collection {
 	return &Float16Collection{
 		builder: builder,
 	}
 }
 
+// NewFloat16CollectionWithValues creates a new Float16 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewFloat16CollectionWithValues(builder *array.Float16Builder) *Float16Collection {
+	return &Float16Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewFloat16CollectionFromArray creates a new read only Float16 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewFloat16CollectionFromArray(arr *array.Float16) *Float16Collection {
+	return &Float16Collection{
+		arr: arr,
+	}
+}
+
 // Float16Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Float16Collection struct {
+	arr     *array.Float16
 	builder *array.Float16Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []float16.Num
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Float16Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Float16Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Float16Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Float16 collection builder can not be read back, use NewFloat16CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Float16Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Float16Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewFloat16(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewFloat16(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Float16Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Float16Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Float16Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Float16 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero float16.Num
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -209,24 +930,128 @@ func (c *Float16Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Float16Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewFloat32Collection creates a new Float32 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewFloat32CollectionWithValues for that.
 func NewFloat32Collection(builder *array.Float32Builder) *Float32Collection {
 	return &Float32Collection{
 		builder: builder,
 	}
 }
 
+// NewFloat32CollectionWithValues creates a new Float32 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewFloat32CollectionWithValues(builder *array.Float32Builder) *Float32Collection {
+	return &Float32Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewFloat32CollectionFromArray creates a new read only Float32 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewFloat32CollectionFromArray(arr *array.Float32) *Float32Collection {
+	return &Float32Collection{
+		arr: arr,
+	}
+}
+
 // Float32Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Float32Collection struct {
+	arr     *array.Float32
 	builder *array.Float32Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []float32
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Float32Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Float32Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Float32Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Float32 collection builder can not be read back, use NewFloat32CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Float32Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Float32Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewFloat32(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewFloat32(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Float32Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Float32Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Float32Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Float32 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero float32
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -236,24 +1061,128 @@ func (c *Float32Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Float32Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewFloat64Collection creates a new Float64 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewFloat64CollectionWithValues for that.
 func NewFloat64Collection(builder *array.Float64Builder) *Float64Collection {
 	return &Float64Collection{
 		builder: builder,
 	}
 }
 
+// NewFloat64CollectionWithValues creates a new Float64 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewFloat64CollectionWithValues(builder *array.Float64Builder) *Float64Collection {
+	return &Float64Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewFloat64CollectionFromArray creates a new read only Float64 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewFloat64CollectionFromArray(arr *array.Float64) *Float64Collection {
+	return &Float64Collection{
+		arr: arr,
+	}
+}
+
 // Float64Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Float64Collection struct {
+	arr     *array.Float64
 	builder *array.Float64Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []float64
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Float64Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Float64Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Float64Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Float64 collection builder can not be read back, use NewFloat64CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Float64Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Float64Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewFloat64(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewFloat64(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Float64Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Float64Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Float64Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Float64 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero float64
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -263,24 +1192,128 @@ func (c *Float64Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Float64Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewInt16Collection creates a new Int16 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewInt16CollectionWithValues for that.
 func NewInt16Collection(builder *array.Int16Builder) *Int16Collection {
 	return &Int16Collection{
 		builder: builder,
 	}
 }
 
+// NewInt16CollectionWithValues creates a new Int16 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewInt16CollectionWithValues(builder *array.Int16Builder) *Int16Collection {
+	return &Int16Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewInt16CollectionFromArray creates a new read only Int16 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewInt16CollectionFromArray(arr *array.Int16) *Int16Collection {
+	return &Int16Collection{
+		arr: arr,
+	}
+}
+
 // Int16Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Int16Collection struct {
+	arr     *array.Int16
 	builder *array.Int16Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []int16
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Int16Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Int16Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Int16Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Int16 collection builder can not be read back, use NewInt16CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Int16Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Int16Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewInt16(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewInt16(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Int16Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Int16Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Int16Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Int16 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero int16
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -290,24 +1323,128 @@ func (c *Int16Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Int16Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewInt32Collection creates a new Int32 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewInt32CollectionWithValues for that.
 func NewInt32Collection(builder *array.Int32Builder) *Int32Collection {
 	return &Int32Collection{
 		builder: builder,
 	}
 }
 
+// NewInt32CollectionWithValues creates a new Int32 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewInt32CollectionWithValues(builder *array.Int32Builder) *Int32Collection {
+	return &Int32Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewInt32CollectionFromArray creates a new read only Int32 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewInt32CollectionFromArray(arr *array.Int32) *Int32Collection {
+	return &Int32Collection{
+		arr: arr,
+	}
+}
+
 // Int32Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Int32Collection struct {
+	arr     *array.Int32
 	builder *array.Int32Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []int32
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Int32Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Int32Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Int32Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Int32 collection builder can not be read back, use NewInt32CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Int32Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Int32Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewInt32(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewInt32(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Int32Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Int32Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Int32Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Int32 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero int32
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -317,24 +1454,128 @@ func (c *Int32Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Int32Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewInt64Collection creates a new Int64 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewInt64CollectionWithValues for that.
 func NewInt64Collection(builder *array.Int64Builder) *Int64Collection {
 	return &Int64Collection{
 		builder: builder,
 	}
 }
 
+// NewInt64CollectionWithValues creates a new Int64 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewInt64CollectionWithValues(builder *array.Int64Builder) *Int64Collection {
+	return &Int64Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewInt64CollectionFromArray creates a new read only Int64 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewInt64CollectionFromArray(arr *array.Int64) *Int64Collection {
+	return &Int64Collection{
+		arr: arr,
+	}
+}
+
 // Int64Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Int64Collection struct {
+	arr     *array.Int64
 	builder *array.Int64Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []int64
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Int64Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Int64Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Int64Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Int64 collection builder can not be read back, use NewInt64CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Int64Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Int64Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewInt64(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewInt64(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Int64Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Int64Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Int64Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Int64 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero int64
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -344,24 +1585,128 @@ func (c *Int64Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Int64Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewInt8Collection creates a new Int8 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewInt8CollectionWithValues for that.
 func NewInt8Collection(builder *array.Int8Builder) *Int8Collection {
 	return &Int8Collection{
 		builder: builder,
 	}
 }
 
+// NewInt8CollectionWithValues creates a new Int8 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewInt8CollectionWithValues(builder *array.Int8Builder) *Int8Collection {
+	return &Int8Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewInt8CollectionFromArray creates a new read only Int8 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewInt8CollectionFromArray(arr *array.Int8) *Int8Collection {
+	return &Int8Collection{
+		arr: arr,
+	}
+}
+
 // Int8Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Int8Collection struct {
+	arr     *array.Int8
 	builder *array.Int8Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []int8
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Int8Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Int8Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Int8Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Int8 collection builder can not be read back, use NewInt8CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Int8Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Int8Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewInt8(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewInt8(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Int8Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Int8Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Int8Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Int8 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero int8
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -371,24 +1716,128 @@ func (c *Int8Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Int8Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewMonthIntervalCollection creates a new MonthInterval collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewMonthIntervalCollectionWithValues for that.
 func NewMonthIntervalCollection(builder *array.MonthIntervalBuilder) *MonthIntervalCollection {
 	return &MonthIntervalCollection{
 		builder: builder,
 	}
 }
 
+// NewMonthIntervalCollectionWithValues creates a new MonthInterval collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewMonthIntervalCollectionWithValues(builder *array.MonthIntervalBuilder) *MonthIntervalCollection {
+	return &MonthIntervalCollection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewMonthIntervalCollectionFromArray creates a new read only MonthInterval collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewMonthIntervalCollectionFromArray(arr *array.MonthInterval) *MonthIntervalCollection {
+	return &MonthIntervalCollection{
+		arr: arr,
+	}
+}
+
 // MonthIntervalCollection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type MonthIntervalCollection struct {
+	arr     *array.MonthInterval
 	builder *array.MonthIntervalBuilder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []arrow.MonthInterval
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *MonthIntervalCollection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *MonthIntervalCollection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *MonthIntervalCollection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the MonthInterval collection builder can not be read back, use NewMonthIntervalCollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *MonthIntervalCollection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *MonthIntervalCollection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewMonthInterval(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewMonthInterval(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *MonthIntervalCollection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *MonthIntervalCollection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *MonthIntervalCollection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only MonthInterval collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero arrow.MonthInterval
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -398,24 +1847,128 @@ func (c *MonthIntervalCollection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *MonthIntervalCollection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewStringCollection creates a new String collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewStringCollectionWithValues for that.
 func NewStringCollection(builder *array.StringBuilder) *StringCollection {
 	return &StringCollection{
 		builder: builder,
 	}
 }
 
+// NewStringCollectionWithValues creates a new String collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewStringCollectionWithValues(builder *array.StringBuilder) *StringCollection {
+	return &StringCollection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewStringCollectionFromArray creates a new read only String collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewStringCollectionFromArray(arr *array.String) *StringCollection {
+	return &StringCollection{
+		arr: arr,
+	}
+}
+
 // StringCollection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type StringCollection struct {
+	arr     *array.String
 	builder *array.StringBuilder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []string
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *StringCollection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *StringCollection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *StringCollection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the String collection builder can not be read back, use NewStringCollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *StringCollection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *StringCollection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewString(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewString(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *StringCollection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *StringCollection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *StringCollection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only String collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero string
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -425,24 +1978,128 @@ func (c *StringCollection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *StringCollection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewTime32Collection creates a new Time32 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewTime32CollectionWithValues for that.
 func NewTime32Collection(builder *array.Time32Builder) *Time32Collection {
 	return &Time32Collection{
 		builder: builder,
 	}
 }
 
+// NewTime32CollectionWithValues creates a new Time32 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewTime32CollectionWithValues(builder *array.Time32Builder) *Time32Collection {
+	return &Time32Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewTime32CollectionFromArray creates a new read only Time32 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewTime32CollectionFromArray(arr *array.Time32) *Time32Collection {
+	return &Time32Collection{
+		arr: arr,
+	}
+}
+
 // Time32Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Time32Collection struct {
+	arr     *array.Time32
 	builder *array.Time32Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []arrow.Time32
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Time32Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Time32Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Time32Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Time32 collection builder can not be read back, use NewTime32CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Time32Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Time32Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewTime32(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewTime32(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Time32Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Time32Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Time32Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Time32 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero arrow.Time32
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -452,24 +2109,128 @@ func (c *Time32Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Time32Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewTime64Collection creates a new Time64 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewTime64CollectionWithValues for that.
 func NewTime64Collection(builder *array.Time64Builder) *Time64Collection {
 	return &Time64Collection{
 		builder: builder,
 	}
 }
 
+// NewTime64CollectionWithValues creates a new Time64 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewTime64CollectionWithValues(builder *array.Time64Builder) *Time64Collection {
+	return &Time64Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewTime64CollectionFromArray creates a new read only Time64 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewTime64CollectionFromArray(arr *array.Time64) *Time64Collection {
+	return &Time64Collection{
+		arr: arr,
+	}
+}
+
 // Time64Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Time64Collection struct {
+	arr     *array.Time64
 	builder *array.Time64Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []arrow.Time64
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Time64Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Time64Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Time64Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Time64 collection builder can not be read back, use NewTime64CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Time64Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Time64Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewTime64(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewTime64(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Time64Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Time64Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Time64Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Time64 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero arrow.Time64
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -479,24 +2240,128 @@ func (c *Time64Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Time64Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewTimestampCollection creates a new Timestamp collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewTimestampCollectionWithValues for that.
 func NewTimestampCollection(builder *array.TimestampBuilder) *TimestampCollection {
 	return &TimestampCollection{
 		builder: builder,
 	}
 }
 
+// NewTimestampCollectionWithValues creates a new Timestamp collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewTimestampCollectionWithValues(builder *array.TimestampBuilder) *TimestampCollection {
+	return &TimestampCollection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewTimestampCollectionFromArray creates a new read only Timestamp collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewTimestampCollectionFromArray(arr *array.Timestamp) *TimestampCollection {
+	return &TimestampCollection{
+		arr: arr,
+	}
+}
+
 // TimestampCollection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type TimestampCollection struct {
+	arr     *array.Timestamp
 	builder *array.TimestampBuilder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []arrow.Timestamp
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *TimestampCollection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *TimestampCollection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *TimestampCollection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Timestamp collection builder can not be read back, use NewTimestampCollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *TimestampCollection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *TimestampCollection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewTimestamp(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewTimestamp(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *TimestampCollection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *TimestampCollection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *TimestampCollection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Timestamp collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero arrow.Timestamp
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -506,24 +2371,128 @@ func (c *TimestampCollection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *TimestampCollection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewUint16Collection creates a new Uint16 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewUint16CollectionWithValues for that.
 func NewUint16Collection(builder *array.Uint16Builder) *Uint16Collection {
 	return &Uint16Collection{
 		builder: builder,
 	}
 }
 
+// NewUint16CollectionWithValues creates a new Uint16 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewUint16CollectionWithValues(builder *array.Uint16Builder) *Uint16Collection {
+	return &Uint16Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewUint16CollectionFromArray creates a new read only Uint16 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewUint16CollectionFromArray(arr *array.Uint16) *Uint16Collection {
+	return &Uint16Collection{
+		arr: arr,
+	}
+}
+
 // Uint16Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Uint16Collection struct {
+	arr     *array.Uint16
 	builder *array.Uint16Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []uint16
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Uint16Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Uint16Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Uint16Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Uint16 collection builder can not be read back, use NewUint16CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Uint16Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Uint16Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewUint16(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewUint16(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Uint16Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Uint16Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Uint16Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Uint16 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero uint16
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -533,24 +2502,128 @@ func (c *Uint16Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Uint16Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewUint32Collection creates a new Uint32 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewUint32CollectionWithValues for that.
 func NewUint32Collection(builder *array.Uint32Builder) *Uint32Collection {
 	return &Uint32Collection{
 		builder: builder,
 	}
 }
 
+// NewUint32CollectionWithValues creates a new Uint32 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewUint32CollectionWithValues(builder *array.Uint32Builder) *Uint32Collection {
+	return &Uint32Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewUint32CollectionFromArray creates a new read only Uint32 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewUint32CollectionFromArray(arr *array.Uint32) *Uint32Collection {
+	return &Uint32Collection{
+		arr: arr,
+	}
+}
+
 // Uint32Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Uint32Collection struct {
+	arr     *array.Uint32
 	builder *array.Uint32Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []uint32
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Uint32Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Uint32Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Uint32Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Uint32 collection builder can not be read back, use NewUint32CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Uint32Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Uint32Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewUint32(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewUint32(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Uint32Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Uint32Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Uint32Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Uint32 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero uint32
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -560,24 +2633,128 @@ func (c *Uint32Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Uint32Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewUint64Collection creates a new Uint64 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewUint64CollectionWithValues for that.
 func NewUint64Collection(builder *array.Uint64Builder) *Uint64Collection {
 	return &Uint64Collection{
 		builder: builder,
 	}
 }
 
+// NewUint64CollectionWithValues creates a new Uint64 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewUint64CollectionWithValues(builder *array.Uint64Builder) *Uint64Collection {
+	return &Uint64Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewUint64CollectionFromArray creates a new read only Uint64 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewUint64CollectionFromArray(arr *array.Uint64) *Uint64Collection {
+	return &Uint64Collection{
+		arr: arr,
+	}
+}
+
 // Uint64Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Uint64Collection struct {
+	arr     *array.Uint64
 	builder *array.Uint64Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []uint64
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Uint64Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Uint64Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Uint64Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Uint64 collection builder can not be read back, use NewUint64CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Uint64Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Uint64Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewUint64(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewUint64(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Uint64Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Uint64Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Uint64Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Uint64 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero uint64
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -587,24 +2764,128 @@ func (c *Uint64Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Uint64Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
 // NewUint8Collection creates a new Uint8 collection builder.
+// The values appended to it are counted by Len but can not be read back,
+// use NewUint8CollectionWithValues for that.
 func NewUint8Collection(builder *array.Uint8Builder) *Uint8Collection {
 	return &Uint8Collection{
 		builder: builder,
 	}
 }
 
+// NewUint8CollectionWithValues creates a new Uint8 collection builder
+// that keeps a copy of the values appended to it so they can be read back.
+func NewUint8CollectionWithValues(builder *array.Uint8Builder) *Uint8Collection {
+	return &Uint8Collection{
+		builder:    builder,
+		keepValues: true,
+	}
+}
+
+// NewUint8CollectionFromArray creates a new read only Uint8 collection over the values of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewUint8CollectionFromArray(arr *array.Uint8) *Uint8Collection {
+	return &Uint8Collection{
+		arr: arr,
+	}
+}
+
 // Uint8Collection has logic to apply to this type.
+// It reads the values of the array followed by the values appended to the builder, when they are kept.
 type Uint8Collection struct {
+	arr     *array.Uint8
 	builder *array.Uint8Builder
+
+	// values and valid keep the values appended to the builder when keepValues is set
+	// because they can not be read back from it.
+	keepValues bool
+	values     []uint8
+	valid      []bool
+}
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *Uint8Collection) Len() int {
+	if c.builder == nil {
+		return c.arrayLen()
+	}
+	return c.arrayLen() + c.builder.Len()
+}
+
+func (c *Uint8Collection) arrayLen() int {
+	if c.arr == nil {
+		return 0
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder that can not be read back.
+func (c *Uint8Collection) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > len(c.values) {
+		return fmt.Errorf("collection: the values appended to the Uint8 collection builder can not be read back, use NewUint8CollectionWithValues")
+	}
+	return nil
+}
+
+// keptOffset returns the index of the first kept value that is still in the builder.
+// The values before it were taken out of the builder by NewArray.
+func (c *Uint8Collection) keptOffset() int {
+	return len(c.values) - c.builder.Len()
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Uint8Collection) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	if n := c.arrayLen(); i >= n {
+		return object.NewUint8(c.values[c.keptOffset()+i-n])
+	}
+	return object.NewUint8(c.arr.Value(i))
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder and can not be read back, see CheckRead.
+func (c *Uint8Collection) IsNull(i int) bool {
+	if n := c.arrayLen(); i >= n {
+		if err := c.CheckRead(); err != nil {
+			panic(err)
+		}
+		return !c.valid[c.keptOffset()+i-n]
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *Uint8Collection) Iterator() Iterator {
+	return NewIterator(c)
 }
 
 func (c *Uint8Collection) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return fmt.Errorf("cannot append to a read only Uint8 collection")
+	}
+
 	if v == nil {
 		c.builder.AppendNull()
+		if c.keepValues {
+			var zero uint8
+			c.values = append(c.values, zero)
+			c.valid = append(c.valid, false)
+		}
 		return nil
 	}
 
@@ -614,30 +2895,116 @@ func (c *Uint8Collection) AppendObject(v object.Object) error {
 	}
 
 	c.builder.Append(b.Value())
+	if c.keepValues {
+		c.values = append(c.values, b.Value())
+		c.valid = append(c.valid, true)
+	}
 	return nil
 }
 
+// AppendCollection appends each of the values of v.
+// It returns an error when the values of v can not be read back.
+func (c *Uint8Collection) AppendCollection(v Collection) error {
+	return appendCollection(c, v)
+}
+
+// NewCollectionFromArray creates a new read only collection over the values of arr.
+// It returns false when there is no collection for the type of arr.
+// The array is not retained, it must not be released while the collection is in use.
+func NewCollectionFromArray(arr array.Interface) (Collection, bool) {
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		return NewBooleanCollectionFromArray(arr), true
+	case *array.Date32:
+		return NewDate32CollectionFromArray(arr), true
+	case *array.Date64:
+		return NewDate64CollectionFromArray(arr), true
+	case *array.DayTimeInterval:
+		return NewDayTimeIntervalCollectionFromArray(arr), true
+	case *array.Decimal128:
+		return NewDecimal128CollectionFromArray(arr), true
+	case *array.Duration:
+		return NewDurationCollectionFromArray(arr), true
+	case *array.Float16:
+		return NewFloat16CollectionFromArray(arr), true
+	case *array.Float32:
+		return NewFloat32CollectionFromArray(arr), true
+	case *array.Float64:
+		return NewFloat64CollectionFromArray(arr), true
+	case *array.Int16:
+		return NewInt16CollectionFromArray(arr), true
+	case *array.Int32:
+		return NewInt32CollectionFromArray(arr), true
+	case *array.Int64:
+		return NewInt64CollectionFromArray(arr), true
+	case *array.Int8:
+		return NewInt8CollectionFromArray(arr), true
+	case *array.MonthInterval:
+		return NewMonthIntervalCollectionFromArray(arr), true
+	case *array.String:
+		return NewStringCollectionFromArray(arr), true
+	case *array.Time32:
+		return NewTime32CollectionFromArray(arr), true
+	case *array.Time64:
+		return NewTime64CollectionFromArray(arr), true
+	case *array.Timestamp:
+		return NewTimestampCollectionFromArray(arr), true
+	case *array.Uint16:
+		return NewUint16CollectionFromArray(arr), true
+	case *array.Uint32:
+		return NewUint32CollectionFromArray(arr), true
+	case *array.Uint64:
+		return NewUint64CollectionFromArray(arr), true
+	case *array.Uint8:
+		return NewUint8CollectionFromArray(arr), true
+	default:
+		return nil, false
+	}
+}
+
 var (
-	_ Collection = (*BooleanCollection)(nil)
-	_ Collection = (*Date32Collection)(nil)
-	_ Collection = (*Date64Collection)(nil)
-	_ Collection = (*DayTimeIntervalCollection)(nil)
-	_ Collection = (*Decimal128Collection)(nil)
-	_ Collection = (*DurationCollection)(nil)
-	_ Collection = (*Float16Collection)(nil)
-	_ Collection = (*Float32Collection)(nil)
-	_ Collection = (*Float64Collection)(nil)
-	_ Collection = (*Int16Collection)(nil)
-	_ Collection = (*Int32Collection)(nil)
-	_ Collection = (*Int64Collection)(nil)
-	_ Collection = (*Int8Collection)(nil)
-	_ Collection = (*MonthIntervalCollection)(nil)
-	_ Collection = (*StringCollection)(nil)
-	_ Collection = (*Time32Collection)(nil)
-	_ Collection = (*Time64Collection)(nil)
-	_ Collection = (*TimestampCollection)(nil)
-	_ Collection = (*Uint16Collection)(nil)
-	_ Collection = (*Uint32Collection)(nil)
-	_ Collection = (*Uint64Collection)(nil)
-	_ Collection = (*Uint8Collection)(nil)
+	_ Collection  = (*BooleanCollection)(nil)
+	_ ReadChecker = (*BooleanCollection)(nil)
+	_ Collection  = (*Date32Collection)(nil)
+	_ ReadChecker = (*Date32Collection)(nil)
+	_ Collection  = (*Date64Collection)(nil)
+	_ ReadChecker = (*Date64Collection)(nil)
+	_ Collection  = (*DayTimeIntervalCollection)(nil)
+	_ ReadChecker = (*DayTimeIntervalCollection)(nil)
+	_ Collection  = (*Decimal128Collection)(nil)
+	_ ReadChecker = (*Decimal128Collection)(nil)
+	_ Collection  = (*DurationCollection)(nil)
+	_ ReadChecker = (*DurationCollection)(nil)
+	_ Collection  = (*Float16Collection)(nil)
+	_ ReadChecker = (*Float16Collection)(nil)
+	_ Collection  = (*Float32Collection)(nil)
+	_ ReadChecker = (*Float32Collection)(nil)
+	_ Collection  = (*Float64Collection)(nil)
+	_ ReadChecker = (*Float64Collection)(nil)
+	_ Collection  = (*Int16Collection)(nil)
+	_ ReadChecker = (*Int16Collection)(nil)
+	_ Collection  = (*Int32Collection)(nil)
+	_ ReadChecker = (*Int32Collection)(nil)
+	_ Collection  = (*Int64Collection)(nil)
+	_ ReadChecker = (*Int64Collection)(nil)
+	_ Collection  = (*Int8Collection)(nil)
+	_ ReadChecker = (*Int8Collection)(nil)
+	_ Collection  = (*MonthIntervalCollection)(nil)
+	_ ReadChecker = (*MonthIntervalCollection)(nil)
+	_ Collection  = (*StringCollection)(nil)
+	_ ReadChecker = (*StringCollection)(nil)
+	_ Collection  = (*Time32Collection)(nil)
+	_ ReadChecker = (*Time32Collection)(nil)
+	_ Collection  = (*Time64Collection)(nil)
+	_ ReadChecker = (*Time64Collection)(nil)
+	_ Collection  = (*TimestampCollection)(nil)
+	_ ReadChecker = (*TimestampCollection)(nil)
+	_ Collection  = (*Uint16Collection)(nil)
+	_ ReadChecker = (*Uint16Collection)(nil)
+	_ Collection  = (*Uint32Collection)(nil)
+	_ ReadChecker = (*Uint32Collection)(nil)
+	_ Collection  = (*Uint64Collection)(nil)
+	_ ReadChecker = (*Uint64Collection)(nil)
+	_ Collection  = (*Uint8Collection)(nil)
+	_ ReadChecker = (*Uint8Collection)(nil)
 )
@@ -18,10 +18,99 @@ import (
 	"github.com/gomem/gomem/pkg/object"
 )
 
+// Reader gives read access to a sequence of values.
+type Reader interface {
+	// Len returns the number of values.
+	Len() int
+
+	// At returns the value at index i as an Object, nil when the value is null.
+	At(i int) object.Object
+
+	// IsNull returns true when the value at index i is null.
+	IsNull(i int) bool
+}
+
 type Collection interface {
+	Reader
+
+	// Iterator returns an Iterator over the values.
+	Iterator() Iterator
+
 	AppendObject(v object.Object) error
-	// AppendCollection(v Collection) error
-	// At(int) interface{}
+
+	// AppendCollection appends each of the values of v.
+	AppendCollection(v Collection) error
 }
 
 func IsNil(o Collection) bool { return o == nil }
+
+// ReadChecker is implemented by the Readers that may not be able to read back all of their values,
+// like the collections over a builder.
+type ReadChecker interface {
+	// CheckRead returns an error when some of the values can not be read back.
+	CheckRead() error
+}
+
+// CheckRead returns the error of r.CheckRead when r is a ReadChecker.
+func CheckRead(r Reader) error {
+	if c, ok := r.(ReadChecker); ok {
+		return c.CheckRead()
+	}
+	return nil
+}
+
+// Iterator walks the values of a Reader in order.
+type Iterator interface {
+	// Next moves the iterator to the next value. This will return false when there are no more values.
+	Next() bool
+
+	// Object returns the current value as an Object, nil when the value is null.
+	Object() object.Object
+
+	// IsNull returns true when the current value is null.
+	IsNull() bool
+}
+
+// NewIterator creates an Iterator over the values of r.
+func NewIterator(r Reader) Iterator {
+	return &readerIterator{r: r, index: -1}
+}
+
+type readerIterator struct {
+	r     Reader
+	index int
+}
+
+func (it *readerIterator) Next() bool {
+	if it.index >= it.r.Len() {
+		return false
+	}
+	it.index++
+	return it.index < it.r.Len()
+}
+
+func (it *readerIterator) Object() object.Object {
+	return it.r.At(it.index)
+}
+
+func (it *readerIterator) IsNull() bool {
+	return it.r.IsNull(it.index)
+}
+
+// appendCollection appends each of the values of v to c.
+func appendCollection(c Collection, v Collection) error {
+	if v == nil {
+		return nil
+	}
+	if err := CheckRead(v); err != nil {
+		return err
+	}
+	// Read the length first so a collection can be appended to itself.
+	n := v.Len()
+	for i := 0; i < n; i++ {
+		if err := c.AppendObject(v.At(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package collection_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/collection"
+	"github.com/gomem/gomem/pkg/gomem"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/object"
+)
+
+// collect reads the values of it as a string, with null values shown as (null).
+func collect(it collection.Iterator) string {
+	var values []string
+	for it.Next() {
+		if it.IsNull() {
+			values = append(values, "(null)")
+			continue
+		}
+		values = append(values, fmt.Sprintf("%v", it.Object()))
+	}
+	return "[" + strings.Join(values, " ") + "]"
+}
+
+func TestCollectionReadAccess(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	ab := array.NewInt64Builder(pool)
+	defer ab.Release()
+	ab.AppendValues([]int64{1, 2, 3}, []bool{true, false, true})
+	arr := ab.NewInt64Array()
+	defer arr.Release()
+
+	read := collection.NewInt64CollectionFromArray(arr)
+	if got, want := read.Len(), 3; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := read.At(2), object.Object(object.Int64(3)); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if !read.IsNull(1) || read.At(1) != nil {
+		t.Fatalf("expected index 1 to be null")
+	}
+	if err := read.AppendObject(object.Int64(4)); err == nil {
+		t.Fatal("expected an error appending to a read only collection")
+	}
+
+	b := array.NewInt64Builder(pool)
+	defer b.Release()
+
+	c := collection.NewInt64CollectionWithValues(b)
+	if err := c.AppendObject(object.Int32(10)); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AppendCollection(read); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AppendCollection(c); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AppendObject(object.String("x")); err == nil {
+		t.Fatal("expected an error appending a String to an Int64 collection")
+	}
+
+	want := "[10 1 (null) 3 10 1 (null) 3]"
+	if got := collect(c.Iterator()); got != want {
+		t.Fatalf("\ngot=%v\nwant=%v", got, want)
+	}
+
+	gt := gomem.NewGomemType(c)
+	if got, want := gt.Type(), gomem.CollectionType; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if gt.Collection() == nil {
+		t.Fatal("expected the GomemType to hold the collection")
+	}
+	if got, want := gt.Len(), 8; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got := collect(gt.Iterator()); got != want {
+		t.Fatalf("\ngot=%v\nwant=%v", got, want)
+	}
+
+	// The values read back match the ones in the builder.
+	built := b.NewInt64Array()
+	defer built.Release()
+	if got := collect(collection.NewInt64CollectionFromArray(built).Iterator()); got != want {
+		t.Fatalf("\ngot=%v\nwant=%v", got, want)
+	}
+
+	// NewArray took the values out of the builder so only the ones appended after it are read.
+	if err := c.AppendObject(object.Int64(7)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := collect(c.Iterator()), "[7]"; got != want {
+		t.Fatalf("\ngot=%v\nwant=%v", got, want)
+	}
+
+	// Without keeping the values they are counted but can't be read back.
+	wb := array.NewInt64Builder(pool)
+	defer wb.Release()
+	w := collection.NewInt64Collection(wb)
+	if err := w.AppendCollection(read); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.Len(), 3; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if err := w.CheckRead(); err == nil {
+		t.Fatal("expected an error reading back the values of the builder")
+	}
+	if err := c.AppendCollection(w); err == nil {
+		t.Fatal("expected an error appending a collection that can't be read")
+	}
+	if got, want := wb.Len(), 3; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	obj := gomem.NewGomemType(object.String("a"))
+	if got, want := collect(obj.Iterator()), "[a]"; got != want {
+		t.Fatalf("\ngot=%v\nwant=%v", got, want)
+	}
+}
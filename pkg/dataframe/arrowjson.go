@@ -0,0 +1,388 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/iterator"
+)
+
+// The types below describe the JSON format Arrow uses for its integration tests.
+// https://arrow.apache.org/docs/format/Integration.html#json-test-data-format
+
+type arrowJSONFile struct {
+	Schema  arrowJSONSchema  `json:"schema"`
+	Batches []arrowJSONBatch `json:"batches"`
+}
+
+type arrowJSONSchema struct {
+	Fields   []arrowJSONField    `json:"fields"`
+	Metadata []arrowJSONKeyValue `json:"metadata,omitempty"`
+}
+
+type arrowJSONField struct {
+	Name     string                 `json:"name"`
+	Type     map[string]interface{} `json:"type"`
+	Nullable bool                   `json:"nullable"`
+	Children []arrowJSONField       `json:"children"`
+	Metadata []arrowJSONKeyValue    `json:"metadata,omitempty"`
+}
+
+type arrowJSONKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type arrowJSONBatch struct {
+	Count   int64             `json:"count"`
+	Columns []arrowJSONColumn `json:"columns"`
+}
+
+type arrowJSONColumn struct {
+	Name     string            `json:"name"`
+	Count    int               `json:"count"`
+	Validity []int             `json:"VALIDITY,omitempty"`
+	Offset   interface{}       `json:"OFFSET,omitempty"`
+	Data     interface{}       `json:"DATA,omitempty"`
+	Children []arrowJSONColumn `json:"children,omitempty"`
+}
+
+// ToArrowJSON writes the DataFrame in the JSON format Arrow uses for its integration tests,
+// which describes the schema and the values of each record batch.
+// The DataFrame is written as one batch for each run of rows that are in the same chunk in every column.
+// Logical types are written as the Arrow types they are stored as along with their field metadata.
+func (df *DataFrame) ToArrowJSON(w io.Writer) error {
+	file := arrowJSONFile{
+		Schema: arrowJSONSchema{
+			Fields:   make([]arrowJSONField, 0, len(df.cols)),
+			Metadata: arrowJSONMetadataOf(df.Metadata()),
+		},
+		Batches: make([]arrowJSONBatch, 0),
+	}
+
+	for _, field := range df.schema.Fields() {
+		f, err := arrowJSONFieldOf(field)
+		if err != nil {
+			return err
+		}
+		file.Schema.Fields = append(file.Schema.Fields, f)
+	}
+
+	rr, err := iterator.NewRecordReaderForColumns(df.Columns())
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+
+	for rr.Next() {
+		rec := rr.Record()
+		batch := arrowJSONBatch{
+			Count:   rec.NumRows(),
+			Columns: make([]arrowJSONColumn, 0, rec.NumCols()),
+		}
+		for i, col := range rec.Columns() {
+			c, err := arrowJSONColumnOf(rec.ColumnName(i), col)
+			if err != nil {
+				return err
+			}
+			batch.Columns = append(batch.Columns, c)
+		}
+		file.Batches = append(file.Batches, batch)
+	}
+
+	return json.NewEncoder(w).Encode(file)
+}
+
+func arrowJSONMetadataOf(md arrow.Metadata) []arrowJSONKeyValue {
+	var kvs []arrowJSONKeyValue
+	for i, key := range md.Keys() {
+		kvs = append(kvs, arrowJSONKeyValue{Key: key, Value: md.Values()[i]})
+	}
+	return kvs
+}
+
+func arrowJSONFieldOf(field arrow.Field) (arrowJSONField, error) {
+	f := arrowJSONField{
+		Name:     field.Name,
+		Nullable: field.Nullable,
+		Children: make([]arrowJSONField, 0),
+		Metadata: arrowJSONMetadataOf(field.Metadata),
+	}
+
+	var children []arrow.Field
+	switch dt := field.Type.(type) {
+	case *arrow.NullType:
+		f.Type = map[string]interface{}{"name": "null"}
+	case *arrow.BooleanType:
+		f.Type = map[string]interface{}{"name": "bool"}
+	case *arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type:
+		f.Type = map[string]interface{}{"name": "int", "isSigned": true, "bitWidth": dt.(arrow.FixedWidthDataType).BitWidth()}
+	case *arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type:
+		f.Type = map[string]interface{}{"name": "int", "isSigned": false, "bitWidth": dt.(arrow.FixedWidthDataType).BitWidth()}
+	case *arrow.Float16Type:
+		f.Type = map[string]interface{}{"name": "floatingpoint", "precision": "HALF"}
+	case *arrow.Float32Type:
+		f.Type = map[string]interface{}{"name": "floatingpoint", "precision": "SINGLE"}
+	case *arrow.Float64Type:
+		f.Type = map[string]interface{}{"name": "floatingpoint", "precision": "DOUBLE"}
+	case *arrow.StringType:
+		f.Type = map[string]interface{}{"name": "utf8"}
+	case *arrow.BinaryType:
+		f.Type = map[string]interface{}{"name": "binary"}
+	case *arrow.FixedSizeBinaryType:
+		f.Type = map[string]interface{}{"name": "fixedsizebinary", "byteWidth": dt.ByteWidth}
+	case *arrow.Date32Type:
+		f.Type = map[string]interface{}{"name": "date", "unit": "DAY"}
+	case *arrow.Date64Type:
+		f.Type = map[string]interface{}{"name": "date", "unit": "MILLISECOND"}
+	case *arrow.Time32Type:
+		f.Type = map[string]interface{}{"name": "time", "unit": arrowJSONTimeUnit(dt.Unit), "bitWidth": 32}
+	case *arrow.Time64Type:
+		f.Type = map[string]interface{}{"name": "time", "unit": arrowJSONTimeUnit(dt.Unit), "bitWidth": 64}
+	case *arrow.TimestampType:
+		f.Type = map[string]interface{}{"name": "timestamp", "unit": arrowJSONTimeUnit(dt.Unit)}
+		if dt.TimeZone != "" {
+			f.Type["timezone"] = dt.TimeZone
+		}
+	case *arrow.DurationType:
+		f.Type = map[string]interface{}{"name": "duration", "unit": arrowJSONTimeUnit(dt.Unit)}
+	case *arrow.MonthIntervalType:
+		f.Type = map[string]interface{}{"name": "interval", "unit": "YEAR_MONTH"}
+	case *arrow.DayTimeIntervalType:
+		f.Type = map[string]interface{}{"name": "interval", "unit": "DAY_TIME"}
+	case *arrow.Decimal128Type:
+		f.Type = map[string]interface{}{"name": "decimal", "precision": dt.Precision, "scale": dt.Scale}
+	case *arrow.ListType:
+		f.Type = map[string]interface{}{"name": "list"}
+		children = []arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: true}}
+	case *arrow.FixedSizeListType:
+		f.Type = map[string]interface{}{"name": "fixedsizelist", "listSize": dt.Len()}
+		children = []arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: true}}
+	case *arrow.StructType:
+		f.Type = map[string]interface{}{"name": "struct"}
+		children = dt.Fields()
+	default:
+		return f, fmt.Errorf("dataframe: column %q: unhandled Arrow JSON type %s", field.Name, field.Type)
+	}
+
+	for _, child := range children {
+		c, err := arrowJSONFieldOf(child)
+		if err != nil {
+			return f, err
+		}
+		f.Children = append(f.Children, c)
+	}
+
+	return f, nil
+}
+
+func arrowJSONTimeUnit(unit arrow.TimeUnit) string {
+	switch unit {
+	case arrow.Second:
+		return "SECOND"
+	case arrow.Millisecond:
+		return "MILLISECOND"
+	case arrow.Microsecond:
+		return "MICROSECOND"
+	default:
+		return "NANOSECOND"
+	}
+}
+
+func arrowJSONColumnOf(name string, arr array.Interface) (arrowJSONColumn, error) {
+	n := arr.Len()
+	c := arrowJSONColumn{
+		Name:  name,
+		Count: n,
+	}
+
+	if _, ok := arr.(*array.Null); ok {
+		// Null columns have no buffers.
+		return c, nil
+	}
+
+	c.Validity = make([]int, n)
+	for i := range c.Validity {
+		if arr.IsValid(i) {
+			c.Validity[i] = 1
+		}
+	}
+
+	data := make([]interface{}, n)
+	switch a := arr.(type) {
+	case *array.Boolean:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Int8:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Int16:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Int32:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Uint8:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Uint16:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Uint32:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Float16:
+		for i := range data {
+			data[i] = a.Value(i).Float32()
+		}
+	case *array.Float32:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Float64:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Date32:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.Time32:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.MonthInterval:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+	case *array.DayTimeInterval:
+		for i := range data {
+			data[i] = a.Value(i)
+		}
+
+	// 64-bit integers are written as strings so that they don't lose precision.
+	case *array.Int64:
+		for i := range data {
+			data[i] = strconv.FormatInt(a.Value(i), 10)
+		}
+	case *array.Uint64:
+		for i := range data {
+			data[i] = strconv.FormatUint(a.Value(i), 10)
+		}
+	case *array.Date64:
+		for i := range data {
+			data[i] = strconv.FormatInt(int64(a.Value(i)), 10)
+		}
+	case *array.Time64:
+		for i := range data {
+			data[i] = strconv.FormatInt(int64(a.Value(i)), 10)
+		}
+	case *array.Timestamp:
+		for i := range data {
+			data[i] = strconv.FormatInt(int64(a.Value(i)), 10)
+		}
+	case *array.Duration:
+		for i := range data {
+			data[i] = strconv.FormatInt(int64(a.Value(i)), 10)
+		}
+	case *array.Decimal128:
+		for i := range data {
+			v := a.Value(i)
+			unscaled := new(big.Int).Lsh(big.NewInt(v.HighBits()), 64)
+			unscaled.Add(unscaled, new(big.Int).SetUint64(v.LowBits()))
+			data[i] = unscaled.String()
+		}
+
+	case *array.String:
+		offsets := make([]int32, n+1)
+		for i := range data {
+			data[i] = a.Value(i)
+			offsets[i+1] = offsets[i] + int32(len(a.Value(i)))
+		}
+		c.Offset = offsets
+	case *array.Binary:
+		offsets := make([]int32, n+1)
+		for i := range data {
+			data[i] = strings.ToUpper(hex.EncodeToString(a.Value(i)))
+			offsets[i+1] = offsets[i] + int32(len(a.Value(i)))
+		}
+		c.Offset = offsets
+	case *array.FixedSizeBinary:
+		for i := range data {
+			data[i] = strings.ToUpper(hex.EncodeToString(a.Value(i)))
+		}
+
+	case *array.List:
+		raw := a.Offsets()[a.Offset() : a.Offset()+n+1]
+		offsets := make([]int32, n+1)
+		for i := range offsets {
+			offsets[i] = raw[i] - raw[0]
+		}
+		c.Offset = offsets
+
+		values := array.NewSlice(a.ListValues(), int64(raw[0]), int64(raw[n]))
+		defer values.Release()
+		child, err := arrowJSONColumnOf("item", values)
+		if err != nil {
+			return c, err
+		}
+		c.Children = []arrowJSONColumn{child}
+		return c, nil
+	case *array.FixedSizeList:
+		size := int64(a.DataType().(*arrow.FixedSizeListType).Len())
+		beg := int64(a.Offset()) * size
+		values := array.NewSlice(a.ListValues(), beg, beg+int64(n)*size)
+		defer values.Release()
+		child, err := arrowJSONColumnOf("item", values)
+		if err != nil {
+			return c, err
+		}
+		c.Children = []arrowJSONColumn{child}
+		return c, nil
+	case *array.Struct:
+		fields := a.DataType().(*arrow.StructType).Fields()
+		c.Children = make([]arrowJSONColumn, 0, len(fields))
+		for i, field := range fields {
+			child, err := arrowJSONColumnOf(field.Name, a.Field(i))
+			if err != nil {
+				return c, err
+			}
+			c.Children = append(c.Children, child)
+		}
+		return c, nil
+
+	default:
+		return c, fmt.Errorf("dataframe: column %q: unhandled Arrow JSON type %s", name, arr.DataType())
+	}
+
+	c.Data = data
+	return c, nil
+}
@@ -0,0 +1,326 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/gomem/gomem/pkg/iterator"
+)
+
+// AsOfDirection is which right rows an as-of join may match a left row with.
+type AsOfDirection int
+
+const (
+	// AsOfBackward matches the last right row whose on value is less than or equal to the left one.
+	AsOfBackward AsOfDirection = iota
+	// AsOfForward matches the first right row whose on value is greater than or equal to the left one.
+	AsOfForward
+	// AsOfNearest matches the right row whose on value is closest to the left one,
+	// preferring the backward match on a tie.
+	AsOfNearest
+)
+
+// WithAsOfDirection configures an as-of join to match in the given direction.
+// The default is AsOfBackward.
+func WithAsOfDirection(direction AsOfDirection) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*leftJoinConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithAsOfDirection to: %T", p)
+		}
+		o.asOfDirection = direction
+		return nil
+	}
+}
+
+// WithAsOfTolerance configures an as-of join to only match right rows whose on value
+// is within tolerance of the left one. tolerance is in the units of the on column,
+// e.g. the TimeUnit of a timestamp column.
+func WithAsOfTolerance(tolerance int64) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*leftJoinConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithAsOfTolerance to: %T", p)
+		}
+		if tolerance < 0 {
+			return fmt.Errorf("as-of tolerance (%d) cannot be negative", tolerance)
+		}
+		o.asOfTolerance = tolerance
+		o.hasAsOfTolerance = true
+		return nil
+	}
+}
+
+// AsOfJoin returns a DataFrame containing the as-of join of two DataFrames.
+// Like a LeftJoin, every row of the left DataFrame is kept once, but instead of matching on equal keys
+// it is matched with the most recent row of the right DataFrame whose on value is less than or equal to it
+// and whose by columns are equal. WithAsOfDirection and WithAsOfTolerance change which row matches.
+//
+// Both DataFrames must be sorted by the on column, which must be an integer or temporal column.
+// Uint64 columns aren't supported because their values don't all fit in an int64.
+// Acts like SQL in that nil elements are treated as unknown so nil != nil.
+func (m *Mutator) AsOfJoin(rightDf *DataFrame, on string, by []string, opts ...Option) MutationFunc {
+	cfg, err := newLeftJoinConfig(opts...)
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		columnNames := make([]string, 0, len(by)+1)
+		columnNames = append(columnNames, by...)
+		columnNames = append(columnNames, on)
+
+		data, err := m.newJoinFuncConfig(cfg, leftDf, rightDf, columnNames, true)
+		if err != nil {
+			return nil, err
+		}
+		defer data.Release()
+
+		left, err := newAsOfSide(leftDf, on, by)
+		if err != nil {
+			return nil, err
+		}
+		right, err := newAsOfSide(rightDf, on, by)
+		if err != nil {
+			return nil, err
+		}
+		if !arrow.TypeEqual(leftDf.Column(on).DataType(), rightDf.Column(on).DataType()) {
+			return nil, fmt.Errorf("bullseye/asof: column %s has type %s on the left and %s on the right", on, leftDf.Column(on).DataType(), rightDf.Column(on).DataType())
+		}
+
+		matches := asOfMatches(cfg, left, right)
+
+		leftIterator := iterator.NewStepIteratorForColumns(data.leftColumns)
+		defer leftIterator.Release()
+		rightIterator := iterator.NewStepIteratorForColumns(data.rightColumns)
+		defer rightIterator.Release()
+		for i := 0; leftIterator.Next(); i++ { // Iterate through every row in the left df.
+			leftStepValues := leftIterator.Values()
+
+			cIdx := 0
+
+			// Add all the values from left columns
+			for j := range leftStepValues.Values {
+				data.smartBuilder.Append(cIdx, leftStepValues.Values[j])
+				cIdx++
+			}
+
+			if matches[i] < 0 {
+				for j := 0; j < data.additionalRightColsLen; j++ {
+					data.smartBuilder.Append(cIdx+j, nil)
+				}
+				continue
+			}
+
+			rightStepValues, err := rightIterator.At(int64(matches[i]))
+			if err != nil {
+				return nil, err
+			}
+			for j := data.matchingRightColsLen; j < len(data.rightColumns); j++ {
+				data.smartBuilder.Append(cIdx, rightStepValues.Values[j])
+				cIdx++
+			}
+		}
+
+		return data.buildDataFrame()
+	})
+}
+
+// asOfSide holds the on values and by keys of one side of an as-of join.
+type asOfSide struct {
+	on    []int64
+	hasOn []bool // false when the on value is nil
+	valid []bool // false when the on value or any by value is nil
+	keys  []string
+}
+
+func newAsOfSide(df *DataFrame, on string, by []string) (*asOfSide, error) {
+	n := int(df.NumRows())
+	side := &asOfSide{
+		on:    make([]int64, n),
+		hasOn: make([]bool, n),
+		valid: make([]bool, n),
+		keys:  make([]string, n),
+	}
+
+	// On values are compared as int64s, which can't hold every uint64.
+	if dtype := df.Column(on).DataType(); dtype.ID() == arrow.UINT64 {
+		return nil, fmt.Errorf("bullseye/asof: cannot use column %s of type %s, uint64 values may not fit in an int64", on, dtype)
+	}
+
+	onIterator := iterator.NewValueIterator(df.Column(on))
+	defer onIterator.Release()
+	for i := 0; onIterator.Next(); i++ {
+		v := onIterator.ValueInterface()
+		if v == nil {
+			continue
+		}
+		onValue, ok := asOfValue(v)
+		if !ok {
+			return nil, fmt.Errorf("bullseye/asof: cannot use column %s of type %s, it must be an integer or temporal column", on, onIterator.DataType())
+		}
+		side.on[i] = onValue
+		side.hasOn[i] = true
+		side.valid[i] = true
+	}
+
+	// Nil on values are skipped so they don't need to be in order.
+	last := -1
+	for i := range side.on {
+		if !side.hasOn[i] {
+			continue
+		}
+		if last >= 0 && side.on[i] < side.on[last] {
+			return nil, fmt.Errorf("bullseye/asof: column %s is not sorted at row %d", on, i)
+		}
+		last = i
+	}
+
+	if len(by) == 0 {
+		return side, nil
+	}
+
+	byIterator := iterator.NewStepIteratorForColumns(df.SelectColumns(by...))
+	defer byIterator.Release()
+	var key strings.Builder
+	for i := 0; byIterator.Next(); i++ {
+		key.Reset()
+		for _, v := range byIterator.Values().Values {
+			if v == nil {
+				side.valid[i] = false
+				break
+			}
+			fmt.Fprintf(&key, "%#v\x00", v)
+		}
+		side.keys[i] = key.String()
+	}
+
+	return side, nil
+}
+
+// asOfValue returns v, the value of an integer or temporal column, as an int64.
+func asOfValue(v interface{}) (int64, bool) {
+	switch t := v.(type) {
+	case int8:
+		return int64(t), true
+	case int16:
+		return int64(t), true
+	case int32:
+		return int64(t), true
+	case int64:
+		return t, true
+	case uint8:
+		return int64(t), true
+	case uint16:
+		return int64(t), true
+	case uint32:
+		return int64(t), true
+	case arrow.Date32:
+		return int64(t), true
+	case arrow.Date64:
+		return int64(t), true
+	case arrow.Time32:
+		return int64(t), true
+	case arrow.Time64:
+		return int64(t), true
+	case arrow.Timestamp:
+		return int64(t), true
+	case arrow.Duration:
+		return int64(t), true
+	default:
+		return 0, false
+	}
+}
+
+// asOfMatches returns the index of the right row each left row matches, or -1 when there isn't one.
+// Both sides are merged in order of their on values, remembering the last right row seen for each key.
+func asOfMatches(cfg *leftJoinConfig, left, right *asOfSide) []int {
+	backward := make([]int, len(left.on))
+	forward := make([]int, len(left.on))
+	for i := range backward {
+		backward[i], forward[i] = -1, -1
+	}
+
+	if cfg.asOfDirection != AsOfForward {
+		last := make(map[string]int)
+		j := 0
+		for i := range left.on {
+			if !left.hasOn[i] {
+				continue
+			}
+			for ; j < len(right.on) && (!right.valid[j] || right.on[j] <= left.on[i]); j++ {
+				if right.valid[j] {
+					last[right.keys[j]] = j
+				}
+			}
+			if r, ok := last[left.keys[i]]; ok && left.valid[i] {
+				backward[i] = r
+			}
+		}
+	}
+
+	if cfg.asOfDirection != AsOfBackward {
+		next := make(map[string]int)
+		j := len(right.on) - 1
+		for i := len(left.on) - 1; i >= 0; i-- {
+			if !left.hasOn[i] {
+				continue
+			}
+			for ; j >= 0 && (!right.valid[j] || right.on[j] >= left.on[i]); j-- {
+				if right.valid[j] {
+					next[right.keys[j]] = j
+				}
+			}
+			if r, ok := next[left.keys[i]]; ok && left.valid[i] {
+				forward[i] = r
+			}
+		}
+	}
+
+	matches := backward
+	switch cfg.asOfDirection {
+	case AsOfForward:
+		matches = forward
+	case AsOfNearest:
+		for i := range matches {
+			if forward[i] < 0 {
+				continue
+			}
+			if matches[i] < 0 || right.on[forward[i]]-left.on[i] < left.on[i]-right.on[matches[i]] {
+				matches[i] = forward[i]
+			}
+		}
+	}
+
+	if cfg.hasAsOfTolerance {
+		for i, r := range matches {
+			if r < 0 {
+				continue
+			}
+			distance := left.on[i] - right.on[r]
+			if distance < 0 {
+				distance = -distance
+			}
+			if distance > cfg.asOfTolerance {
+				matches[i] = -1
+			}
+		}
+	}
+
+	return matches
+}
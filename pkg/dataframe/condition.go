@@ -0,0 +1,151 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+
+	"github.com/gomem/gomem/pkg/iterator"
+)
+
+// JoinRow is a row of one of the DataFrames in a ConditionJoin.
+type JoinRow struct {
+	df     *DataFrame
+	values *iterator.StepValue
+}
+
+// Value returns the value of the named column in this row.
+// The value is only valid until the JoinCondition returns.
+func (r JoinRow) Value(name string) (interface{}, error) {
+	i := r.df.columnIndex(name)
+	if i < 0 {
+		return nil, fmt.Errorf("bullseye/condition: column %s is not in DataFrame: (%v)", name, r.df.ColumnNames())
+	}
+	return r.values.Values[i], nil
+}
+
+// Element returns the value of the named column in this row as an Element.
+func (r JoinRow) Element(name string) (Element, error) {
+	i := r.df.columnIndex(name)
+	if i < 0 {
+		return nil, fmt.Errorf("bullseye/condition: column %s is not in DataFrame: (%v)", name, r.df.ColumnNames())
+	}
+	return StepValueElementAt(r.values, i), nil
+}
+
+// JoinCondition returns true when the left row and the right row should be joined.
+type JoinCondition func(left, right JoinRow) (bool, error)
+
+// CompareOp is a comparison between two Elements.
+type CompareOp int
+
+const (
+	// OpEq is the = comparison.
+	OpEq CompareOp = iota
+	// OpNeq is the != comparison.
+	OpNeq
+	// OpLess is the < comparison.
+	OpLess
+	// OpLessEq is the <= comparison.
+	OpLessEq
+	// OpGreater is the > comparison.
+	OpGreater
+	// OpGreaterEq is the >= comparison.
+	OpGreaterEq
+)
+
+// String returns the SQL operator for the comparison.
+func (op CompareOp) String() string {
+	switch op {
+	case OpEq:
+		return "="
+	case OpNeq:
+		return "!="
+	case OpLess:
+		return "<"
+	case OpLessEq:
+		return "<="
+	case OpGreater:
+		return ">"
+	case OpGreaterEq:
+		return ">="
+	default:
+		return fmt.Sprintf("CompareOp(%d)", int(op))
+	}
+}
+
+// compare applies op to the left and right Elements.
+// Acts like SQL in that nil elements are treated as unknown so any comparison with nil is false.
+func (op CompareOp) compare(left, right Element) (bool, error) {
+	if left.IsNil() || right.IsNil() {
+		return false, nil
+	}
+	switch op {
+	case OpEq:
+		return left.Eq(right)
+	case OpNeq:
+		return left.Neq(right)
+	case OpLess:
+		return left.Less(right)
+	case OpLessEq:
+		return left.LessEq(right)
+	case OpGreater:
+		return left.Greater(right)
+	case OpGreaterEq:
+		return left.GreaterEq(right)
+	default:
+		return false, fmt.Errorf("bullseye/condition: unknown comparison %s", op)
+	}
+}
+
+// Compare returns a JoinCondition that compares a column of the left row with a column of the right row,
+// like SQL l.leftColumn op r.rightColumn.
+// Acts like SQL in that nil elements are treated as unknown so they never match.
+func Compare(leftColumn string, op CompareOp, rightColumn string) JoinCondition {
+	return func(left, right JoinRow) (bool, error) {
+		l, err := left.Element(leftColumn)
+		if err != nil {
+			return false, err
+		}
+		r, err := right.Element(rightColumn)
+		if err != nil {
+			return false, err
+		}
+		return op.compare(l, r)
+	}
+}
+
+// Between returns a JoinCondition that matches when a column of the left row is between
+// two columns of the right row, inclusive, like SQL l.leftColumn BETWEEN r.rightStart AND r.rightEnd.
+// Acts like SQL in that nil elements are treated as unknown so they never match.
+func Between(leftColumn, rightStart, rightEnd string) JoinCondition {
+	return And(
+		Compare(leftColumn, OpGreaterEq, rightStart),
+		Compare(leftColumn, OpLessEq, rightEnd),
+	)
+}
+
+// And returns a JoinCondition that matches when all of conds match.
+func And(conds ...JoinCondition) JoinCondition {
+	return func(left, right JoinRow) (bool, error) {
+		for _, cond := range conds {
+			match, err := cond(left, right)
+			if err != nil || !match {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+}
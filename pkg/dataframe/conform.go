@@ -0,0 +1,340 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/metadata"
+	"github.com/gomem/gomem/pkg/object"
+	"github.com/gomem/gomem/pkg/smartbuilder"
+)
+
+// SchemaDiffKind is the kind of difference between a DataFrame and a schema.
+type SchemaDiffKind int
+
+const (
+	// DiffMissing is a field of the schema that the DataFrame doesn't have.
+	DiffMissing SchemaDiffKind = iota
+	// DiffExtra is a column of the DataFrame that the schema doesn't have.
+	DiffExtra
+	// DiffTypeMismatch is a column with a different type than the field of the schema.
+	DiffTypeMismatch
+	// DiffNullability is a column with nulls for a field of the schema that isn't nullable.
+	DiffNullability
+)
+
+func (k SchemaDiffKind) String() string {
+	switch k {
+	case DiffMissing:
+		return "missing"
+	case DiffExtra:
+		return "extra"
+	case DiffTypeMismatch:
+		return "type mismatch"
+	case DiffNullability:
+		return "nullability violation"
+	default:
+		return fmt.Sprintf("SchemaDiffKind(%d)", int(k))
+	}
+}
+
+// SchemaDiff is a single difference between a DataFrame and a schema.
+type SchemaDiff struct {
+	Kind SchemaDiffKind
+	// Name is the name of the field or column.
+	Name string
+	// Want is the type of the field in the schema. It is nil for DiffExtra.
+	Want arrow.DataType
+	// Got is the type of the column in the DataFrame. It is nil for DiffMissing.
+	Got arrow.DataType
+	// Nulls is the number of null rows for DiffNullability.
+	Nulls int
+}
+
+func (d SchemaDiff) String() string {
+	switch d.Kind {
+	case DiffMissing:
+		return fmt.Sprintf("%s: missing, want %s", d.Name, d.Want)
+	case DiffExtra:
+		return fmt.Sprintf("%s: extra column of type %s", d.Name, d.Got)
+	case DiffTypeMismatch:
+		return fmt.Sprintf("%s: got type %s, want %s", d.Name, d.Got, d.Want)
+	case DiffNullability:
+		return fmt.Sprintf("%s: %d null rows in a non-nullable field", d.Name, d.Nulls)
+	default:
+		return fmt.Sprintf("%s: %s", d.Name, d.Kind)
+	}
+}
+
+// SchemaError is returned when a DataFrame doesn't conform to a schema.
+type SchemaError struct {
+	Diffs []SchemaDiff
+}
+
+func (e *SchemaError) Error() string {
+	diffs := make([]string, len(e.Diffs))
+	for i, diff := range e.Diffs {
+		diffs[i] = diff.String()
+	}
+	return "bullseye/conform: DataFrame does not conform to schema: " + strings.Join(diffs, "; ")
+}
+
+// conformConfig are the config params for ConformTo.
+type conformConfig struct {
+	noCast bool
+	noAdd  bool
+	noDrop bool
+}
+
+// WithoutCasting configures ConformTo to return an error for columns of a different type instead of casting them.
+func WithoutCasting() Option {
+	return func(p interface{}) error {
+		o, ok := p.(*conformConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithoutCasting to: %T", p)
+		}
+		o.noCast = true
+		return nil
+	}
+}
+
+// WithoutAddingColumns configures ConformTo to return an error for missing fields instead of adding null columns.
+func WithoutAddingColumns() Option {
+	return func(p interface{}) error {
+		o, ok := p.(*conformConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithoutAddingColumns to: %T", p)
+		}
+		o.noAdd = true
+		return nil
+	}
+}
+
+// WithoutDroppingColumns configures ConformTo to return an error for extra columns instead of dropping them.
+func WithoutDroppingColumns() Option {
+	return func(p interface{}) error {
+		o, ok := p.(*conformConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithoutDroppingColumns to: %T", p)
+		}
+		o.noDrop = true
+		return nil
+	}
+}
+
+// Validate returns the differences between the DataFrame and schema, or nil when the DataFrame conforms to it.
+// The order of the columns doesn't matter. Non-nullable fields must not contain nulls.
+func (df *DataFrame) Validate(schema *arrow.Schema) []SchemaDiff {
+	var diffs []SchemaDiff
+	for _, field := range schema.Fields() {
+		col := df.Column(field.Name)
+		if col == nil {
+			diffs = append(diffs, SchemaDiff{Kind: DiffMissing, Name: field.Name, Want: field.Type})
+			continue
+		}
+		if !sameFieldType(col.Field(), field) {
+			diffs = append(diffs, SchemaDiff{Kind: DiffTypeMismatch, Name: field.Name, Want: field.Type, Got: col.DataType()})
+		}
+		if nulls := col.NullN(); !field.Nullable && nulls > 0 {
+			diffs = append(diffs, SchemaDiff{Kind: DiffNullability, Name: field.Name, Want: field.Type, Got: col.DataType(), Nulls: nulls})
+		}
+	}
+	for _, col := range df.Columns() {
+		if !schema.HasField(col.Name()) {
+			diffs = append(diffs, SchemaDiff{Kind: DiffExtra, Name: col.Name(), Got: col.DataType()})
+		}
+	}
+	return diffs
+}
+
+// ConformTo returns a DataFrame with the fields of schema, in its order. Columns of a different type are cast,
+// missing fields are added as null columns and extra columns are dropped, unless configured otherwise with
+// WithoutCasting, WithoutAddingColumns and WithoutDroppingColumns. Non-nullable fields must not end up with nulls.
+// The schema metadata of schema is merged into the schema metadata of the DataFrame.
+// A *SchemaError is returned with the differences that couldn't be resolved.
+func (m *Mutator) ConformTo(schema *arrow.Schema, opts ...Option) MutationFunc {
+	cfg := &conformConfig{}
+	var err error
+	for _, opt := range opts {
+		if err = opt(cfg); err != nil {
+			break
+		}
+	}
+
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		var diffs []SchemaDiff
+		for _, diff := range df.Validate(schema) {
+			switch {
+			case diff.Kind == DiffMissing && cfg.noAdd:
+				diffs = append(diffs, diff)
+			case diff.Kind == DiffMissing && df.NumRows() > 0:
+				// A missing field is added as a column of nulls so it has to be nullable.
+				if fields, _ := schema.FieldsByName(diff.Name); !fields[0].Nullable {
+					diffs = append(diffs, SchemaDiff{Kind: DiffNullability, Name: diff.Name, Want: diff.Want, Nulls: int(df.NumRows())})
+				}
+			case diff.Kind == DiffExtra && cfg.noDrop:
+				diffs = append(diffs, diff)
+			case diff.Kind == DiffTypeMismatch && cfg.noCast:
+				diffs = append(diffs, diff)
+			case diff.Kind == DiffNullability:
+				diffs = append(diffs, diff)
+			}
+		}
+		if len(diffs) > 0 {
+			return nil, &SchemaError{Diffs: diffs}
+		}
+
+		cols := make([]array.Column, 0, len(schema.Fields()))
+		defer func() {
+			for i := range cols {
+				cols[i].Release()
+			}
+		}()
+		for _, field := range schema.Fields() {
+			col, err := m.conformColumn(df, field)
+			if err != nil {
+				return nil, err
+			}
+			cols = append(cols, *col)
+		}
+
+		out, err := NewDataFrameFromShape(m.mem, cols, df.NumRows())
+		if err != nil {
+			return nil, err
+		}
+		// The schema metadata of the DataFrame is kept with the metadata of schema merged into it.
+		out.setMetadata(metadata.Merge(df.Metadata(), schema.Metadata()))
+		return out, nil
+	})
+}
+
+// conformColumn returns the column of df for field, cast to the type of field or filled with nulls when it's missing.
+func (m *Mutator) conformColumn(df *DataFrame, field arrow.Field) (*array.Column, error) {
+	col := df.Column(field.Name)
+	if col != nil && sameFieldType(col.Field(), field) {
+		chunked := array.NewChunked(field.Type, col.Data().Chunks())
+		defer chunked.Release()
+		return array.NewColumn(field, chunked), nil
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{field}, nil)
+	recordBuilder := array.NewRecordBuilder(m.mem, schema)
+	defer recordBuilder.Release()
+	smartBuilder := smartbuilder.NewSmartBuilder(recordBuilder)
+
+	if col == nil {
+		for i := int64(0); i < df.NumRows(); i++ {
+			if err := smartBuilder.Append(0, nil); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := castColumn(smartBuilder, col, field); err != nil {
+		return nil, err
+	}
+
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+	chunked := array.NewChunked(field.Type, rec.Columns())
+	defer chunked.Release()
+	return array.NewColumn(field, chunked), nil
+}
+
+// castColumn appends the values of col to the first field of smartBuilder, cast to the type of field.
+// Dictionary columns are cast from their decoded values.
+func castColumn(smartBuilder *smartbuilder.SmartBuilder, col *array.Column, field arrow.Field) error {
+	from, to := col.DataType(), field.Type
+	if metadata.DictionaryTypeMetadataExists(col.Field().Metadata) {
+		from = arrow.BinaryTypes.String
+	}
+	if metadata.DictionaryTypeMetadataExists(field.Metadata) {
+		to = arrow.BinaryTypes.String
+	}
+	switch from.(type) {
+	case *arrow.ListType, *arrow.FixedSizeListType, *arrow.StructType:
+		return fmt.Errorf("bullseye/conform: cannot cast column %s from %s to %s", col.Name(), col.DataType(), field.Type)
+	}
+
+	it := iterator.NewValueIterator(col)
+	defer it.Release()
+	for row := 0; it.Next(); row++ {
+		v := it.ValueInterface()
+		if v == nil {
+			if err := smartBuilder.Append(0, nil); err != nil {
+				return err
+			}
+			continue
+		}
+
+		obj, ok := object.CastToDataType(from, v)
+		if !ok {
+			return fmt.Errorf("bullseye/conform: column %s, row %d: cannot cast %v from %s to %s", col.Name(), row, v, col.DataType(), field.Type)
+		}
+		cast, ok := castObject(to, obj)
+		if !ok {
+			if _, ok := object.CastToDataType(to, obj); ok {
+				return fmt.Errorf("bullseye/conform: column %s, row %d: %v overflows %s", col.Name(), row, v, field.Type)
+			}
+			return fmt.Errorf("bullseye/conform: column %s, row %d: cannot cast %v from %s to %s", col.Name(), row, v, col.DataType(), field.Type)
+		}
+		if err := smartBuilder.Append(0, cast); err != nil {
+			return fmt.Errorf("bullseye/conform: column %s, row %d: %w", col.Name(), row, err)
+		}
+	}
+	return nil
+}
+
+// castObject casts obj to the Object type of dtype, returning false when it doesn't fit.
+// Floating point values are truncated when cast to an integer type.
+// Casting to a floating point type only loses precision so it is never checked.
+func castObject(dtype arrow.DataType, obj object.Object) (object.Object, bool) {
+	switch dtype.(type) {
+	case *arrow.Float16Type, *arrow.Float32Type, *arrow.Float64Type:
+		return object.CastToDataType(dtype, obj)
+	case *arrow.Int8Type, *arrow.Int16Type, *arrow.Int32Type, *arrow.Int64Type,
+		*arrow.Uint8Type, *arrow.Uint16Type, *arrow.Uint32Type, *arrow.Uint64Type:
+		switch f := obj.(type) {
+		case object.Float16:
+			obj = object.Float64(math.Trunc(float64(float16.Num(f).Float32())))
+		case object.Float32:
+			obj = object.Float64(math.Trunc(float64(f)))
+		case object.Float64:
+			obj = object.Float64(math.Trunc(float64(f)))
+		}
+	}
+	return object.CastToDataTypeChecked(dtype, obj)
+}
+
+// sameFieldType returns true when the fields hold the same physical type and the same dictionary, if any.
+func sameFieldType(got, want arrow.Field) bool {
+	if !arrow.TypeEqual(got.Type, want.Type) {
+		return false
+	}
+	gotDictionary := metadata.DictionaryTypeMetadataExists(got.Metadata)
+	if gotDictionary != metadata.DictionaryTypeMetadataExists(want.Metadata) {
+		return false
+	}
+	return !gotDictionary || metadata.SameDictionary(got.Metadata, want.Metadata)
+}
@@ -0,0 +1,209 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/gomemtest"
+)
+
+func TestValidate(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []interface{}{int32(1), nil, int32(3)},
+		"B": []string{"x", "y", "z"},
+		"C": []float64{1, 2, 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "A", Type: arrow.PrimitiveTypes.Int32},
+			{Name: "B", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "D", Type: arrow.BinaryTypes.String, Nullable: true},
+		},
+		nil,
+	)
+
+	got := df.Validate(schema)
+	want := []SchemaDiff{
+		{Kind: DiffNullability, Name: "A", Want: arrow.PrimitiveTypes.Int32, Got: arrow.PrimitiveTypes.Int32, Nulls: 1},
+		{Kind: DiffTypeMismatch, Name: "B", Want: arrow.PrimitiveTypes.Int64, Got: arrow.BinaryTypes.String},
+		{Kind: DiffMissing, Name: "D", Want: arrow.BinaryTypes.String},
+		{Kind: DiffExtra, Name: "C", Got: arrow.PrimitiveTypes.Float64},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	// NewDataFrameFromMem marks A as nullable because it has a null.
+	if got := df.Validate(df.Schema()); len(got) != 0 {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	selected, err := df.Select("B", "C")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer selected.Release()
+	if diffs := selected.Validate(selected.Schema()); diffs != nil {
+		t.Fatalf("got=%v, want no diffs", diffs)
+	}
+}
+
+func TestNewDataFrameNonNullable(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "A", Type: arrow.PrimitiveTypes.Int32}}, nil)
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 0, 3}, []bool{true, false, true})
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	if df, err := NewDataFrameFromRecord(pool, rec); err == nil {
+		df.Release()
+		t.Fatal("expected an error creating a DataFrame with a null in a non-nullable field")
+	}
+}
+
+func TestConformTo(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []int32{1, 2, 3},
+		"B": []interface{}{"x", nil, "z"},
+		"C": []float64{1.5, 2.5, 3.5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "C", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "A", Type: arrow.BinaryTypes.String},
+			{Name: "D", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		},
+		nil,
+	)
+
+	conformed, err := df.ConformTo(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conformed.Release()
+
+	if !conformed.Schema().Equal(schema) {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", conformed.Schema(), schema)
+	}
+	got := conformed.Display(-1)
+	want := `rec[0]["C"]: [1 2 3]
+rec[0]["A"]: ["1" "2" "3"]
+rec[0]["D"]: [(null) (null) (null)]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	// B has a null so it can't conform to a non-nullable field.
+	strict := arrow.NewSchema([]arrow.Field{{Name: "B", Type: arrow.BinaryTypes.String}}, nil)
+	_, err = df.ConformTo(strict)
+	var schemaErr *SchemaError
+	if !errors.As(err, &schemaErr) {
+		t.Fatalf("got=%v, want a *SchemaError", err)
+	}
+	if got, want := schemaErr.Diffs, []SchemaDiff{{Kind: DiffNullability, Name: "B", Want: arrow.BinaryTypes.String, Got: arrow.BinaryTypes.String, Nulls: 1}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if _, err := df.ConformTo(schema, WithoutCasting()); err == nil {
+		t.Fatal("expected an error without casting")
+	}
+	if _, err := df.ConformTo(schema, WithoutAddingColumns()); err == nil {
+		t.Fatal("expected an error without adding columns")
+	}
+	if _, err := df.ConformTo(schema, WithoutDroppingColumns()); err == nil {
+		t.Fatal("expected an error without dropping columns")
+	}
+
+	unparsable := arrow.NewSchema([]arrow.Field{{Name: "B", Type: arrow.PrimitiveTypes.Int64, Nullable: true}}, nil)
+	if _, err := df.ConformTo(unparsable); err == nil {
+		t.Fatal("expected an error casting a string to an integer")
+	}
+}
+
+func TestConformToOverflow(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []int64{1, math.MaxInt32 + 1},
+		"B": []float64{-1.5, math.NaN()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	tests := []struct {
+		field arrow.Field
+		want  string
+	}{
+		{
+			field: arrow.Field{Name: "A", Type: arrow.PrimitiveTypes.Int32},
+			want:  "bullseye/conform: column A, row 1: 2147483648 overflows int32",
+		},
+		{
+			field: arrow.Field{Name: "B", Type: arrow.PrimitiveTypes.Uint8},
+			want:  "bullseye/conform: column B, row 0: -1.5 overflows uint8",
+		},
+	}
+	for _, tc := range tests {
+		_, err := df.ConformTo(arrow.NewSchema([]arrow.Field{tc.field}, nil))
+		if err == nil {
+			t.Fatalf("expected an error casting column %s to %s", tc.field.Name, tc.field.Type)
+		}
+		if got := err.Error(); got != tc.want {
+			t.Fatalf("got=%v, want=%v", got, tc.want)
+		}
+	}
+
+	// The values that fit are cast.
+	sliced, err := df.Slice(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sliced.Release()
+	conformed, err := sliced.ConformTo(arrow.NewSchema([]arrow.Field{{Name: "A", Type: arrow.PrimitiveTypes.Int32}}, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conformed.Release()
+	if got, want := conformed.Display(-1), "rec[0][\"A\"]: [1]\n"; got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
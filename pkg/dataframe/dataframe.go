@@ -27,6 +27,7 @@ import (
 	"github.com/gomem/gomem/internal/constructors"
 	"github.com/gomem/gomem/internal/debug"
 	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/metadata"
 	"github.com/gomem/gomem/pkg/smartbuilder"
 )
 
@@ -72,6 +73,10 @@ func NewDataFrame(mem memory.Allocator, schema *arrow.Schema, arrs []array.Inter
 		if int64(arr.Len()) < df.rows {
 			return nil, fmt.Errorf("dataframe: column %q expected length >= %d but got length %d", ft.Name, df.rows, arr.Len())
 		}
+
+		if !ft.Nullable && arr.NullN() > 0 {
+			return nil, fmt.Errorf("dataframe: column %q is not nullable but has %d nulls", ft.Name, arr.NullN())
+		}
 	}
 
 	df.cols = make([]array.Column, len(arrs))
@@ -84,6 +89,7 @@ func NewDataFrame(mem memory.Allocator, schema *arrow.Schema, arrs []array.Inter
 			df.cols[i] = *col
 		}(i)
 	}
+	debug.TrackNewRef(df, df.cols...)
 
 	return df, nil
 }
@@ -159,6 +165,7 @@ func NewDataFrameFromShape(mem memory.Allocator, cols []array.Column, rows int64
 	for i := range df.cols {
 		df.cols[i].Retain()
 	}
+	debug.TrackNewRef(df, df.cols...)
 
 	return df, nil
 }
@@ -207,6 +214,16 @@ func (df *DataFrame) Column(name string) *array.Column {
 	return nil
 }
 
+// columnIndex returns the index of the column matching the given name or -1 if it does not exist.
+func (df *DataFrame) columnIndex(name string) int {
+	for i, col := range df.cols {
+		if col.Name() == name {
+			return i
+		}
+	}
+	return -1
+}
+
 // ColumnAt returns the i-th column of this Frame.
 func (df *DataFrame) ColumnAt(i int) *array.Column {
 	return &df.cols[i]
@@ -353,6 +370,7 @@ func (df *DataFrame) RejectColumns(names ...string) []array.Column {
 }
 
 // Apply takes a series of MutationFunc and calls them with the existing DataFrame on the left.
+// A MutationFunc that exceeds the budget of a LimitedAllocator makes Apply return an *ErrMemoryLimit.
 func (df *DataFrame) Apply(fns ...MutationFunc) (*DataFrame, error) {
 	left, err := df.Copy()
 	if err != nil {
@@ -362,8 +380,9 @@ func (df *DataFrame) Apply(fns ...MutationFunc) (*DataFrame, error) {
 		return left, err
 	}
 	for i := range fns {
-		left, err = func() (*DataFrame, error) {
+		left, err = func() (_ *DataFrame, err error) {
 			defer left.Release()
+			defer recoverMemoryLimit(&err)
 			return fns[i](left)
 		}()
 		if err != nil {
@@ -420,7 +439,22 @@ func (df *DataFrame) AppendColumn(c *array.Column) (*DataFrame, error) {
 	cols := make([]array.Column, nCols+1)
 	copy(cols, df.cols)
 	cols[nCols] = *c
-	return NewDataFrameFromShape(df.mem, cols, df.rows)
+	return df.inheritMetadata(NewDataFrameFromShape(df.mem, cols, df.rows))
+}
+
+// replaceColumnFromChunks builds a new DataFrame with the i-th column replaced
+// by a column made from field and chunks.
+func (df *DataFrame) replaceColumnFromChunks(i int, field arrow.Field, chunks []array.Interface) (*DataFrame, error) {
+	chunked := array.NewChunked(field.Type, chunks)
+	defer chunked.Release()
+
+	col := array.NewColumn(field, chunked)
+	defer col.Release()
+
+	cols := make([]array.Column, len(df.cols))
+	copy(cols, df.cols)
+	cols[i] = *col
+	return df.inheritMetadata(NewDataFrameFromShape(df.mem, cols, df.rows))
 }
 
 // Copy returns a copy of this dataframe. The underlying byte buffers will not be copied.
@@ -428,7 +462,18 @@ func (df *DataFrame) Copy() (*DataFrame, error) {
 	nCols := len(df.cols)
 	cols := make([]array.Column, nCols)
 	copy(cols, df.cols)
-	return NewDataFrameFromShape(df.mem, cols, df.rows)
+	return df.inheritMetadata(NewDataFrameFromShape(df.mem, cols, df.rows))
+}
+
+// Categorize creates a new DataFrame with the named string column dictionary-encoded.
+func (df *DataFrame) Categorize(name string) (*DataFrame, error) {
+	return df.mutator.Categorize(name)(df)
+}
+
+// Decategorize creates a new DataFrame with the named dictionary-encoded column
+// converted back into a string column.
+func (df *DataFrame) Decategorize(name string) (*DataFrame, error) {
+	return df.mutator.Decategorize(name)(df)
 }
 
 // CrossJoin returns a DataFrame containing the cross join of two DataFrames.
@@ -437,6 +482,86 @@ func (df *DataFrame) CrossJoin(right *DataFrame, opts ...Option) (*DataFrame, er
 	return fn(df)
 }
 
+// AsOfJoin returns a DataFrame containing the as-of join of two DataFrames.
+// Each row is matched with the most recent row of right whose on value is less than or equal to it
+// and whose by columns are equal.
+func (df *DataFrame) AsOfJoin(right *DataFrame, on string, by []string, opts ...Option) (*DataFrame, error) {
+	fn := df.mutator.AsOfJoin(right, on, by, opts...)
+	return fn(df)
+}
+
+// ConditionJoin returns a DataFrame containing the combinations of rows of two DataFrames for which cond returns true.
+func (df *DataFrame) ConditionJoin(right *DataFrame, cond JoinCondition, opts ...Option) (*DataFrame, error) {
+	fn := df.mutator.ConditionJoin(right, cond, opts...)
+	return fn(df)
+}
+
+// Head returns a DataFrame with the first n rows.
+func (df *DataFrame) Head(n int64) (*DataFrame, error) {
+	fn := df.mutator.Head(n)
+	return fn(df)
+}
+
+// Tail returns a DataFrame with the last n rows.
+func (df *DataFrame) Tail(n int64) (*DataFrame, error) {
+	fn := df.mutator.Tail(n)
+	return fn(df)
+}
+
+// Take returns a DataFrame with the rows at indices, in the order of indices.
+func (df *DataFrame) Take(indices *array.Int64) (*DataFrame, error) {
+	fn := df.mutator.Take(indices)
+	return fn(df)
+}
+
+// Sample returns a DataFrame with n rows drawn at random using seed.
+func (df *DataFrame) Sample(n int64, seed int64, opts ...Option) (*DataFrame, error) {
+	fn := df.mutator.Sample(n, seed, opts...)
+	return fn(df)
+}
+
+// SampleFrac returns a DataFrame with frac of the rows drawn at random using seed.
+func (df *DataFrame) SampleFrac(frac float64, seed int64, opts ...Option) (*DataFrame, error) {
+	fn := df.mutator.SampleFrac(frac, seed, opts...)
+	return fn(df)
+}
+
+// ValueCounts returns a DataFrame with the distinct values of the named column and how many rows hold each of them.
+func (df *DataFrame) ValueCounts(name string, normalize, dropNull bool) (*DataFrame, error) {
+	fn := df.mutator.ValueCounts(name, normalize, dropNull)
+	return fn(df)
+}
+
+// Unique returns a DataFrame with the distinct values of the named column in the order they first appear.
+func (df *DataFrame) Unique(name string) (*DataFrame, error) {
+	fn := df.mutator.Unique(name)
+	return fn(df)
+}
+
+// Histogram returns a DataFrame counting the values of the named numeric column in bins of equal width.
+func (df *DataFrame) Histogram(name string, bins int) (*DataFrame, error) {
+	fn := df.mutator.Histogram(name, bins)
+	return fn(df)
+}
+
+// ConformTo returns a DataFrame with the fields of schema, casting, adding and dropping columns as needed.
+func (df *DataFrame) ConformTo(schema *arrow.Schema, opts ...Option) (*DataFrame, error) {
+	fn := df.mutator.ConformTo(schema, opts...)
+	return fn(df)
+}
+
+// Distinct returns a DataFrame without the rows that duplicate an earlier row in the subset columns.
+func (df *DataFrame) Distinct(subset ...string) (*DataFrame, error) {
+	fn := df.mutator.Distinct(subset...)
+	return fn(df)
+}
+
+// DropDuplicates returns a DataFrame without the rows that duplicate another row in the subset columns.
+func (df *DataFrame) DropDuplicates(subset []string, opts ...Option) (*DataFrame, error) {
+	fn := df.mutator.DropDuplicates(subset, opts...)
+	return fn(df)
+}
+
 // Select the given DataFrame columns by name.
 func (df *DataFrame) Select(names ...string) (*DataFrame, error) {
 	fn := df.mutator.Select(names...)
@@ -474,6 +599,24 @@ func (df *DataFrame) RightJoin(right *DataFrame, columns []string, opts ...Optio
 	return fn(df)
 }
 
+// SemiJoin returns a DataFrame containing the rows of this DataFrame that match at least one row of right.
+func (df *DataFrame) SemiJoin(right *DataFrame, columns []string, opts ...Option) (*DataFrame, error) {
+	fn := df.mutator.SemiJoin(right, columns, opts...)
+	return fn(df)
+}
+
+// AntiJoin returns a DataFrame containing the rows of this DataFrame that don't match any row of right.
+func (df *DataFrame) AntiJoin(right *DataFrame, columns []string, opts ...Option) (*DataFrame, error) {
+	fn := df.mutator.AntiJoin(right, columns, opts...)
+	return fn(df)
+}
+
+// Concat creates a new DataFrame with the rows of this DataFrame followed by the rows of each of others.
+func (df *DataFrame) Concat(others ...*DataFrame) (*DataFrame, error) {
+	fn := df.mutator.Concat(others...)
+	return fn(df)
+}
+
 // Slice creates a new DataFrame consisting of rows[beg:end].
 func (df *DataFrame) Slice(beg, end int64) (*DataFrame, error) {
 	return df.mutator.Slice(beg, end)(df)
@@ -487,7 +630,7 @@ func (df *DataFrame) Schema() *arrow.Schema {
 // Retain increases the reference count by 1.
 // Retain may be called simultaneously from multiple goroutines.
 func (df *DataFrame) Retain() {
-	atomic.AddInt64(&df.refs, 1)
+	debug.TrackRef(df, atomic.AddInt64(&df.refs, 1))
 }
 
 // Release decreases the reference count by 1.
@@ -496,6 +639,7 @@ func (df *DataFrame) Retain() {
 func (df *DataFrame) Release() {
 	refs := atomic.AddInt64(&df.refs, -1)
 	debug.Assert(refs >= 0, "too many releases")
+	debug.TrackRef(df, refs)
 
 	if refs == 0 {
 		for i := range df.cols {
@@ -517,6 +661,9 @@ func (df *DataFrame) validate() error {
 		if colLen < df.rows {
 			return fmt.Errorf("dataframe validate(): column %q expected length >= %d but got length %d", col.Name(), df.rows, colLen)
 		}
+		if !col.Field().Nullable && col.NullN() > 0 {
+			return fmt.Errorf("dataframe validate(): column %q is not nullable but has %d nulls", col.Name(), col.NullN())
+		}
 	}
 	return nil
 }
@@ -530,6 +677,14 @@ func compareColumns(left, right *array.Column) bool {
 		return false
 	}
 
+	// Columns sharing a dictionary can be compared on their indices.
+	if metadata.SameDictionary(left.Field().Metadata, right.Field().Metadata) {
+		left = dictionaryIndices(left)
+		defer left.Release()
+		right = dictionaryIndices(right)
+		defer right.Release()
+	}
+
 	// Let's use the stuff we already have to do all columns
 	it := iterator.NewStepIteratorForColumns([]array.Column{*left, *right})
 	defer it.Release()
@@ -15,14 +15,19 @@
 package dataframe
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/gomemtest"
 	"github.com/gomem/gomem/pkg/iterator"
 	"github.com/gomem/gomem/pkg/smartbuilder"
 )
@@ -34,11 +39,11 @@ const (
 	COL1NAME = "f2-f64"
 )
 
-func buildRecords(pool *memory.CheckedAllocator, t *testing.T, last int32) ([]array.Record, *arrow.Schema) {
+func buildRecords(pool memory.Allocator, t *testing.T, last int32) ([]array.Record, *arrow.Schema) {
 	schema := arrow.NewSchema(
 		[]arrow.Field{
-			{Name: COL0NAME, Type: arrow.PrimitiveTypes.Int32},
-			{Name: COL1NAME, Type: arrow.PrimitiveTypes.Float64},
+			{Name: COL0NAME, Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			{Name: COL1NAME, Type: arrow.PrimitiveTypes.Float64, Nullable: true},
 		},
 		nil,
 	)
@@ -66,7 +71,7 @@ func buildRecords(pool *memory.CheckedAllocator, t *testing.T, last int32) ([]ar
 	return []array.Record{rec1, rec2, rec3}, schema
 }
 
-func getColumns(pool *memory.CheckedAllocator, t *testing.T, last int32) []array.Column {
+func getColumns(pool memory.Allocator, t *testing.T, last int32) []array.Column {
 	records, schema := buildRecords(pool, t, last)
 	for i := range records {
 		defer records[i].Release()
@@ -94,8 +99,7 @@ func genValues(length int) []int32 {
 }
 
 func TestNewDataFrameFromColumns(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -114,8 +118,7 @@ func TestNewDataFrameFromColumns(t *testing.T) {
 }
 
 func TestNumCols(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -134,8 +137,7 @@ func TestNumCols(t *testing.T) {
 }
 
 func TestNumRows(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -154,8 +156,7 @@ func TestNumRows(t *testing.T) {
 }
 
 func TestDims(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -180,8 +181,7 @@ func TestDims(t *testing.T) {
 }
 
 func TestEquals(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -214,8 +214,7 @@ func TestEquals(t *testing.T) {
 
 func TestEqualsFalse(t *testing.T) {
 	// This test makes sure Equals returns false as well as true.
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -247,8 +246,7 @@ func TestEqualsFalse(t *testing.T) {
 }
 
 func TestName(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -271,8 +269,7 @@ func TestName(t *testing.T) {
 }
 
 func TestSlice(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -297,8 +294,7 @@ func TestSlice(t *testing.T) {
 }
 
 func TestColumnNames(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -315,8 +311,7 @@ func TestColumnNames(t *testing.T) {
 }
 
 func TestColumnTypes(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -346,8 +341,7 @@ func TestColumnTypes(t *testing.T) {
 }
 
 func TestAppendColumn(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	cols := getColumns(pool, t, 40)
 	for i := range cols {
@@ -391,8 +385,7 @@ rec[2]["col3-i32"]: [20 21 22 23 24 25 26 27 28 29]
 }
 
 func TestCopy(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -423,8 +416,7 @@ rec[0]["col2-f64"]: [1 2 3 4 5 6 7 8 9 10]
 }
 
 func TestSelect(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -458,8 +450,7 @@ rec[0]["col6-f64"]: [1 2 3 4 5 6 7 8 9 10]
 }
 
 func TestDrop(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -493,8 +484,7 @@ rec[0]["col5-i32"]: [1 2 3 4 5 6 7 8 9 10]
 }
 
 func TestNewDataFrameFromMem(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -517,8 +507,7 @@ rec[1]["col2-f64"]: [6 7 8 9 10]
 }
 
 func TestNewColumnFromSparseMem(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	values := []interface{}{1, nil, 3}
 	valueIndexes := []int{0, 2, 4}
@@ -543,8 +532,7 @@ func TestNewColumnFromSparseMem(t *testing.T) {
 }
 
 func TestColumn(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -575,8 +563,7 @@ func TestColumn(t *testing.T) {
 }
 
 func TestColumnAt(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -600,8 +587,7 @@ func TestColumnAt(t *testing.T) {
 }
 
 func TestLeftJoin(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	leftDf, err := NewDataFrameFromMem(pool, Dict{
 		"A": []float32{5, 2, 3, 1},
@@ -643,8 +629,7 @@ rec[0]["F"]: [7 (null) (null) (null)]
 }
 
 func TestLeftJoinCase2(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	// This test is meant to test LeftJoin
 	// when there will be duplicate leftDf rows
@@ -689,8 +674,7 @@ rec[0]["F"]: [7 (null) 99 44 (null)]
 }
 
 func TestLeftJoinCase3(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	// This test is meant to test LeftJoin
 	// when there is only one column to match on
@@ -736,8 +720,7 @@ rec[0]["F"]: [7 8 5 99 44 (null)]
 }
 
 func TestRightJoin(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	leftDf, err := NewDataFrameFromMem(pool, Dict{
 		"A": []float64{5, 2, 3, 1},
@@ -779,8 +762,7 @@ rec[0]["C"]: [1.7 (null) (null) (null)]
 }
 
 func TestRightJoinCase2(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	// This test is meant to test RightJoin
 	// when there will be duplicate rightDf rows
@@ -825,8 +807,7 @@ rec[0]["C"]: [1.7 2.3 9.1 (null)]
 }
 
 func TestRightJoinCase3(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	// This test is meant to test RightJoin
 	// when there is only one column to match on
@@ -872,8 +853,7 @@ rec[0]["D_0"]: [5 (null) 1 5 0 0]
 }
 
 func TestInnerJoinCase1(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	leftDf, err := NewDataFrameFromMem(pool, Dict{
 		"A": []float64{1, 7, 6, 1},
@@ -915,8 +895,7 @@ rec[0]["F"]: [2 9]
 }
 
 func TestOuterJoin(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	leftDf, err := NewDataFrameFromMem(pool, Dict{
 		"A": []int32{5, 2, 3, 1},
@@ -958,8 +937,7 @@ rec[0]["F"]: [7 (null) (null) (null) 3 5 8]
 }
 
 func TestOuterJoinCase2(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	leftDf, err := NewDataFrameFromMem(pool, Dict{
 		"A": []uint8{5, 2, 3, 1},
@@ -1002,8 +980,7 @@ rec[0]["F"]: [7 8 5 (null) (null) 3]
 }
 
 func TestOuterJoinCase3(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	// When elements are nil at the same location we should not consider them equal as they are unknown.
 	// This follows SQL practices.
@@ -1046,9 +1023,66 @@ rec[0]["F"]: [(null) (null) (null) (null) 7 3 5 8]
 	}
 }
 
+func TestSemiJoin(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	leftDf, err := NewDataFrameFromMem(pool, Dict{
+		"A": []interface{}{int32(5), int32(2), int32(3), nil, int32(5)},
+		"B": []float64{6, 4, 3, 2, 1},
+		"D": []int64{5, 1, 0, 0, 0},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer leftDf.Release()
+
+	rightDf, err := NewDataFrameFromMem(pool, Dict{
+		"A": []interface{}{int32(5), int32(2), int32(5), nil},
+		"F": []float64{7, 3, 5, 8},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rightDf.Release()
+
+	semiDf, err := leftDf.SemiJoin(rightDf, []string{"A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer semiDf.Release()
+
+	// Each left row is kept once even though 5 matches twice and nil never matches.
+	got := semiDf.Display(-1)
+	want := `rec[0]["A"]: [5 2 5]
+rec[0]["B"]: [6 4 1]
+rec[0]["D"]: [5 1 0]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	antiDf, err := leftDf.AntiJoin(rightDf, []string{"A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer antiDf.Release()
+
+	got = antiDf.Display(-1)
+	want = `rec[0]["A"]: [3 (null)]
+rec[0]["B"]: [3 2]
+rec[0]["D"]: [0 0]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	if _, err := leftDf.SemiJoin(rightDf, []string{"D"}); err == nil {
+		t.Fatal("expected an error joining on a column missing from the right DataFrame")
+	}
+}
+
 func TestCrossJoin(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	leftDf, err := NewDataFrameFromMem(pool, Dict{
 		"A": []int64{5, 2, 3, 1},
@@ -1092,8 +1126,7 @@ rec[0]["F"]: [7 3 5 8 11 7 3 5 8 11 7 3 5 8 11 7 3 5 8 11]
 }
 
 func TestJoinSuffix(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	// This test is meant to test RightJoin
 	// when there is only one column to match on
@@ -1141,8 +1174,7 @@ rec[0]["D_left"]: [5 (null) 1 5 0 0]
 func TestInconsistentDataTypesError(t *testing.T) {
 	// When elements are nil at the same location we should not consider them equal as they are unknown.
 	// This follows SQL practices.
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"A": []interface{}{nil, 2, 3, 1.2},
@@ -1197,8 +1229,7 @@ func multByN(columnName string, multipier float64) MutationFunc {
 }
 
 func TestApply(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -1229,8 +1260,7 @@ rec[0]["col2-f64"]: [-2 -4 -6 -8 -10 -12 -14 -16 -18 -20]
 }
 
 func TestApplyToColumn(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	df, err := NewDataFrameFromMem(pool, Dict{
 		"col1-i32": []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
@@ -1275,8 +1305,7 @@ rec[0]["col2-f64-x2"]: [2 4 6 8 10 12 14 16 18 20]
 }
 
 func TestNewDataFrameFromTable(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	records, schema := buildRecords(pool, t, 48)
 	for i := range records {
@@ -1304,3 +1333,863 @@ rec[2]["f2-f64"]: [31 32 33 34 35 36 37 38 39 40]
 		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
 	}
 }
+
+func TestRows(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	cols := getColumns(pool, t, 40)
+	for i := range cols {
+		defer cols[i].Release()
+	}
+
+	df, err := NewDataFrameFromColumns(pool, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	var n int64
+	for row, step := range df.Rows() {
+		if got, want := row, n; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		if got, want := len(step.Values), NUMCOLS; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		n++
+	}
+	if got, want := n, NUMROWS; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+
+	// Breaking out early must not leak the iterators.
+	for row := range df.Rows() {
+		if row == 3 {
+			break
+		}
+	}
+}
+
+func TestChunks(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	cols := getColumns(pool, t, 40)
+	for i := range cols {
+		defer cols[i].Release()
+	}
+
+	df, err := NewDataFrameFromColumns(pool, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	offsets := make([]int64, 0)
+	for offset, chunk := range df.Chunks(COL1NAME) {
+		if got, want := chunk.Len(), 10; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		offsets = append(offsets, offset)
+	}
+	if got, want := offsets, []int64{0, 10, 20}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	for range df.Chunks("missing") {
+		t.Fatal("expected no chunks for a missing column")
+	}
+}
+
+func TestJoinLeftOnRightOn(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	leftDf, err := NewDataFrameFromMem(pool, Dict{
+		"id": []interface{}{int64(1), int64(2), nil},
+		"B":  []float64{1.5, 2.5, 3.5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer leftDf.Release()
+
+	rightDf, err := NewDataFrameFromMem(pool, Dict{
+		"key": []interface{}{int64(2), nil, int64(3)},
+		"F":   []float64{20, 30, 40},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rightDf.Release()
+
+	joinedDf, err := leftDf.InnerJoin(rightDf, nil, WithLeftOn("id"), WithRightOn("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer joinedDf.Release()
+
+	got := joinedDf.Display(-1)
+	want := `rec[0]["id"]: [2]
+rec[0]["B"]: [2.5]
+rec[0]["F"]: [20]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	// With null-safe equality the nil keys match each other.
+	nullSafeDf, err := leftDf.InnerJoin(rightDf, nil, WithLeftOn("id"), WithRightOn("key"), WithNullSafeEquality())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nullSafeDf.Release()
+
+	got = nullSafeDf.Display(-1)
+	want = `rec[0]["id"]: [2 (null)]
+rec[0]["B"]: [2.5 3.5]
+rec[0]["F"]: [20 30]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	// RightJoin also names the keys after the left DataFrame.
+	rightJoinedDf, err := leftDf.RightJoin(rightDf, nil, WithLeftOn("id"), WithRightOn("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rightJoinedDf.Release()
+
+	got = rightJoinedDf.Display(-1)
+	want = `rec[0]["id"]: [2 (null) 3]
+rec[0]["F"]: [20 30 40]
+rec[0]["B"]: [2.5 (null) (null)]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	if _, err := leftDf.InnerJoin(rightDf, nil, WithLeftOn("id")); err == nil {
+		t.Fatal("expected an error using WithLeftOn without WithRightOn")
+	}
+	if _, err := leftDf.InnerJoin(rightDf, []string{"id"}, WithLeftOn("id"), WithRightOn("key")); err == nil {
+		t.Fatal("expected an error using column names together with WithLeftOn and WithRightOn")
+	}
+}
+
+func TestConditionJoin(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	leftDf, err := NewDataFrameFromMem(pool, Dict{
+		"ts":    []interface{}{int64(1), int64(5), int64(10), nil},
+		"price": []float64{1.5, 2.5, 3.5, 4.5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer leftDf.Release()
+
+	rightDf, err := NewDataFrameFromMem(pool, Dict{
+		"start":  []int64{0, 4, 5},
+		"end":    []int64{5, 6, 9},
+		"period": []string{"a", "b", "c"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rightDf.Release()
+
+	joinedDf, err := leftDf.ConditionJoin(rightDf, Between("ts", "start", "end"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer joinedDf.Release()
+
+	got := joinedDf.Display(-1)
+	want := `rec[0]["price"]: [1.5 2.5 2.5 2.5]
+rec[0]["ts"]: [1 5 5 5]
+rec[0]["end"]: [5 5 6 9]
+rec[0]["period"]: ["a" "a" "b" "c"]
+rec[0]["start"]: [0 0 4 5]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	if _, err := leftDf.ConditionJoin(rightDf, Compare("ts", OpLess, "missing")); err == nil {
+		t.Fatal("expected an error comparing a column missing from the right DataFrame")
+	}
+}
+
+func TestElementOrdering(t *testing.T) {
+	f16 := func(f float32) *Float16Element { return NewFloat16Element(float16.New(f)) }
+	cases := []struct {
+		left, right *Float16Element
+		less        bool
+		greater     bool
+	}{
+		{f16(-2), f16(-1), true, false},
+		{f16(-1), f16(1), true, false},
+		{f16(1), f16(-1), false, true},
+		{f16(-0.5), f16(-0.25), true, false},
+		{f16(float32(math.NaN())), f16(1), false, false},
+	}
+	for _, c := range cases {
+		less, err := c.left.Less(c.right)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := less, c.less; got != want {
+			t.Errorf("%v < %v: got=%v, want=%v", c.left.v, c.right.v, got, want)
+		}
+		greater, err := c.left.Greater(c.right)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := greater, c.greater; got != want {
+			t.Errorf("%v > %v: got=%v, want=%v", c.left.v, c.right.v, got, want)
+		}
+	}
+
+	// Days and milliseconds are not a total order.
+	day := NewDayTimeIntervalElement(arrow.DayTimeInterval{Days: 1})
+	ms := NewDayTimeIntervalElement(arrow.DayTimeInterval{Milliseconds: 1})
+	if _, err := day.Less(ms); err == nil {
+		t.Fatal("expected an error ordering DayTimeInterval elements")
+	}
+	if eq, err := day.Eq(day); err != nil || !eq {
+		t.Fatalf("got=%v (err=%v), want=true", eq, err)
+	}
+}
+
+func TestAsOfJoin(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	trades, err := NewDataFrameFromMem(pool, Dict{
+		"time":   []int64{1, 3, 5, 7, 9},
+		"ticker": []string{"A", "B", "A", "A", "B"},
+		"qty":    []int32{10, 20, 30, 40, 50},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer trades.Release()
+
+	quotes, err := NewDataFrameFromMem(pool, Dict{
+		"time":   []int64{0, 2, 3, 6, 8},
+		"ticker": []string{"A", "B", "A", "A", "A"},
+		"bid":    []float64{1.0, 2.0, 1.1, 1.2, 1.3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer quotes.Release()
+
+	cases := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "backward",
+			want: `rec[0]["ticker"]: ["A" "B" "A" "A" "B"]
+rec[0]["time"]: [1 3 5 7 9]
+rec[0]["qty"]: [10 20 30 40 50]
+rec[0]["bid"]: [1 2 1.1 1.2 2]
+`,
+		},
+		{
+			name: "forward",
+			opts: []Option{WithAsOfDirection(AsOfForward)},
+			want: `rec[0]["ticker"]: ["A" "B" "A" "A" "B"]
+rec[0]["time"]: [1 3 5 7 9]
+rec[0]["qty"]: [10 20 30 40 50]
+rec[0]["bid"]: [1.1 (null) 1.2 1.3 (null)]
+`,
+		},
+		{
+			name: "nearest",
+			opts: []Option{WithAsOfDirection(AsOfNearest)},
+			want: `rec[0]["ticker"]: ["A" "B" "A" "A" "B"]
+rec[0]["time"]: [1 3 5 7 9]
+rec[0]["qty"]: [10 20 30 40 50]
+rec[0]["bid"]: [1 2 1.2 1.2 2]
+`,
+		},
+		{
+			name: "tolerance",
+			opts: []Option{WithAsOfTolerance(1)},
+			want: `rec[0]["ticker"]: ["A" "B" "A" "A" "B"]
+rec[0]["time"]: [1 3 5 7 9]
+rec[0]["qty"]: [10 20 30 40 50]
+rec[0]["bid"]: [1 2 (null) 1.2 (null)]
+`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			joinedDf, err := trades.AsOfJoin(quotes, "time", []string{"ticker"}, c.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer joinedDf.Release()
+
+			if got := joinedDf.Display(-1); got != c.want {
+				t.Fatalf("\ngot=\n%v\nwant=\n%v", got, c.want)
+			}
+		})
+	}
+
+	unsorted, err := NewDataFrameFromMem(pool, Dict{
+		"time": []int64{2, 1},
+		"bid":  []float64{1, 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsorted.Release()
+	if _, err := trades.AsOfJoin(unsorted, "time", nil); err == nil {
+		t.Fatal("expected an error joining a DataFrame that isn't sorted")
+	}
+	if _, err := trades.AsOfJoin(quotes, "ticker", nil); err == nil {
+		t.Fatal("expected an error joining on a string column")
+	}
+
+	unsigned, err := NewDataFrameFromMem(pool, Dict{
+		"time": []uint64{1, 1 << 63},
+		"bid":  []float64{1, 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsigned.Release()
+	if _, err := unsigned.AsOfJoin(unsigned, "time", nil); err == nil {
+		t.Fatal("expected an error joining on a uint64 column")
+	}
+}
+
+func TestDropDuplicates(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []interface{}{int32(1), int32(2), int32(1), nil, nil, int32(2)},
+		"B": []interface{}{"a", "b", "a", "c", "c", "x"},
+		"C": []float64{1, 2, 3, 4, 5, 6},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	tests := []struct {
+		name   string
+		subset []string
+		opts   []Option
+		want   string
+	}{
+		{
+			name:   "first",
+			subset: []string{"A", "B"},
+			want: `rec[0]["A"]: [1 2 (null) 2]
+rec[0]["B"]: ["a" "b" "c" "x"]
+rec[0]["C"]: [1 2 4 6]
+`,
+		},
+		{
+			name:   "last",
+			subset: []string{"A"},
+			opts:   []Option{WithKeep(KeepLast)},
+			want: `rec[0]["A"]: [1 (null) 2]
+rec[0]["B"]: ["a" "c" "x"]
+rec[0]["C"]: [3 5 6]
+`,
+		},
+		{
+			name:   "none",
+			subset: []string{"A", "B"},
+			opts:   []Option{WithKeep(KeepNone)},
+			want: `rec[0]["A"]: [2 2]
+rec[0]["B"]: ["b" "x"]
+rec[0]["C"]: [2 6]
+`,
+		},
+		{
+			name:   "nulls distinct",
+			subset: []string{"A", "B"},
+			opts:   []Option{WithKeep(KeepNone), WithNullsDistinct()},
+			want: `rec[0]["A"]: [2 (null) (null) 2]
+rec[0]["B"]: ["b" "c" "c" "x"]
+rec[0]["C"]: [2 4 5 6]
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dedupDf, err := df.DropDuplicates(tc.subset, tc.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer dedupDf.Release()
+
+			if got := dedupDf.Display(-1); got != tc.want {
+				t.Fatalf("\ngot=\n%v\nwant=\n%v", got, tc.want)
+			}
+		})
+	}
+
+	// All the columns are compared by default so nothing is dropped.
+	distinctDf, err := df.Distinct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer distinctDf.Release()
+	if got, want := distinctDf.NumRows(), df.NumRows(); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if _, err := df.Distinct("Z"); err == nil {
+		t.Fatal("expected an error for a missing column")
+	}
+}
+
+func TestDropDuplicatesNaN(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	nan := math.NaN()
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []interface{}{nan, 1.0, nil, nan, math.Inf(1), nil, math.Inf(1), math.Copysign(0, -1), 0.0},
+		"B": []interface{}{"a", "a", "b", "a", "c", "b", "c", "d", "d"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	distinctDf, err := df.Distinct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer distinctDf.Release()
+
+	got := distinctDf.Display(-1)
+	want := `rec[0]["A"]: [NaN 1 (null) +Inf -0]
+rec[0]["B"]: ["a" "a" "b" "c" "d"]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
+
+func TestTake(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "A", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+			{Name: "struct", Type: arrow.StructOf(arrow.Field{Name: "f", Type: arrow.BinaryTypes.String})},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	// Two records so that Take has to gather across the chunks.
+	var records []array.Record
+	for _, vals := range [][]int32{{0, 1}, {2, 3, 4}} {
+		lb := b.Field(1).(*array.ListBuilder)
+		vb := lb.ValueBuilder().(*array.Int32Builder)
+		sb := b.Field(2).(*array.StructBuilder)
+		fb := sb.FieldBuilder(0).(*array.StringBuilder)
+		for _, v := range vals {
+			if v == 3 {
+				b.Field(0).(*array.Int32Builder).AppendNull()
+				lb.AppendNull()
+			} else {
+				b.Field(0).(*array.Int32Builder).Append(v)
+				lb.Append(true)
+				vb.AppendValues([]int32{v, v * 10}, nil)
+			}
+			sb.Append(true)
+			fb.Append(fmt.Sprintf("s%d", v))
+		}
+		rec := b.NewRecord()
+		defer rec.Release()
+		records = append(records, rec)
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	df, err := NewDataFrameFromTable(pool, tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	ib := array.NewInt64Builder(pool)
+	defer ib.Release()
+	ib.AppendValues([]int64{4, 0, 3, 1, 4}, nil)
+	indices := ib.NewInt64Array()
+	defer indices.Release()
+
+	takeDf, err := df.Take(indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer takeDf.Release()
+
+	var buf bytes.Buffer
+	if err := takeDf.ToJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"A":4,"list":[4,40],"struct":{"f":"s4"}}
+{"A":0,"list":[0,0],"struct":{"f":"s0"}}
+{"A":null,"list":null,"struct":{"f":"s3"}}
+{"A":1,"list":[1,10],"struct":{"f":"s1"}}
+{"A":4,"list":[4,40],"struct":{"f":"s4"}}
+`
+	if got := buf.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	headDf, err := df.Head(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer headDf.Release()
+	if got, want := headDf.Display(-1), "rec[0][\"A\"]: [0 1]\n"; !strings.HasPrefix(got, want) {
+		t.Fatalf("got=%v, want prefix=%v", got, want)
+	}
+
+	tailDf, err := df.Tail(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tailDf.Release()
+	if got, want := tailDf.NumRows(), int64(5); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	ib.AppendValues([]int64{5}, nil)
+	outOfRange := ib.NewInt64Array()
+	defer outOfRange.Release()
+	if _, err := df.Take(outOfRange); err == nil {
+		t.Fatal("expected an error for an index out of range")
+	}
+}
+
+func TestTakeNullNested(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "struct", Type: arrow.StructOf(arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Int64, Nullable: true}), Nullable: true},
+			{Name: "fsl", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int64), Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+	sb := b.Field(0).(*array.StructBuilder)
+	fb := sb.FieldBuilder(0).(*array.Int64Builder)
+	lb := b.Field(1).(*array.FixedSizeListBuilder)
+	vb := lb.ValueBuilder().(*array.Int64Builder)
+	for _, v := range []int64{1, 0, 3} {
+		if v == 0 {
+			sb.AppendNull()
+			lb.AppendNull()
+			vb.AppendValues([]int64{0, 0}, []bool{false, false})
+			continue
+		}
+		sb.Append(true)
+		fb.Append(v)
+		lb.Append(true)
+		vb.AppendValues([]int64{v, v * 10}, nil)
+	}
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	df, err := NewDataFrameFromRecord(pool, rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	ib := array.NewInt64Builder(pool)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 2}, nil)
+	indices := ib.NewInt64Array()
+	defer indices.Release()
+
+	takeDf, err := df.Take(indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer takeDf.Release()
+
+	// The children of a null row hold nulls so the rows after it stay aligned.
+	structs := takeDf.Column("struct").Data().Chunk(0).(*array.Struct)
+	if got, want := structs.Field(0).(*array.Int64).String(), "[(null) 3]"; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	lists := takeDf.Column("fsl").Data().Chunk(0).(*array.FixedSizeList)
+	if got, want := lists.ListValues().(*array.Int64).String(), "[(null) (null) 3 30]"; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if !structs.IsNull(0) || structs.IsNull(1) || !lists.IsNull(0) || lists.IsNull(1) {
+		t.Fatal("expected only the first row to be null")
+	}
+}
+
+func TestSample(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	cols := getColumns(pool, t, 40)
+	for i := range cols {
+		defer cols[i].Release()
+	}
+
+	df, err := NewDataFrameFromColumns(pool, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	sample1, err := df.Sample(8, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sample1.Release()
+	sample2, err := df.Sample(8, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sample2.Release()
+
+	if got, want := sample1.NumRows(), int64(8); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if !sample1.Equals(sample2) {
+		t.Fatalf("expected the same seed to draw the same rows\ngot=\n%v\nwant=\n%v", sample2.Display(-1), sample1.Display(-1))
+	}
+
+	// Without replacement every row is drawn at most once.
+	all, err := df.SampleFrac(1, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer all.Release()
+	distinct, err := all.Distinct(COL1NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer distinct.Release()
+	if got, want := distinct.NumRows(), df.NumRows(); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	replaced, err := df.Sample(100, 7, WithReplacement())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer replaced.Release()
+	if got, want := replaced.NumRows(), int64(100); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if _, err := df.Sample(100, 7); err == nil {
+		t.Fatal("expected an error sampling more rows than there are without replacement")
+	}
+}
+
+func TestValueCounts(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []interface{}{"b", "a", nil, "a", "c", "b", "a"},
+		"B": []interface{}{int64(3), int64(1), int64(2), nil, int64(1), int64(3), int64(3)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	catDf, err := df.Categorize("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer catDf.Release()
+
+	tests := []struct {
+		name      string
+		df        *DataFrame
+		col       string
+		normalize bool
+		dropNull  bool
+		want      string
+	}{
+		{
+			name: "string",
+			df:   df,
+			col:  "A",
+			want: `rec[0]["A"]: ["a" "b" (null) "c"]
+rec[0]["count"]: [3 2 1 1]
+`,
+		},
+		{
+			name:     "drop null",
+			df:       df,
+			col:      "B",
+			dropNull: true,
+			want: `rec[0]["B"]: [3 1 2]
+rec[0]["count"]: [3 2 1]
+`,
+		},
+		{
+			name:      "normalize",
+			df:        df,
+			col:       "B",
+			normalize: true,
+			dropNull:  true,
+			want: `rec[0]["B"]: [3 1 2]
+rec[0]["proportion"]: [0.5 0.3333333333333333 0.16666666666666666]
+`,
+		},
+		{
+			// The dictionary indices of "a", "b" and "c" are 0, 1 and 2.
+			name: "dictionary",
+			df:   catDf,
+			col:  "A",
+			want: `rec[0]["A"]: [0 1 (null) 2]
+rec[0]["count"]: [3 2 1 1]
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			countsDf, err := tc.df.ValueCounts(tc.col, tc.normalize, tc.dropNull)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer countsDf.Release()
+
+			if got := countsDf.Display(-1); got != tc.want {
+				t.Fatalf("\ngot=\n%v\nwant=\n%v", got, tc.want)
+			}
+		})
+	}
+
+	uniqueDf, err := df.Unique("B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer uniqueDf.Release()
+	if got, want := uniqueDf.Display(-1), "rec[0][\"B\"]: [3 1 2 (null)]\n"; got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	if _, err := df.ValueCounts("Z", false, false); err == nil {
+		t.Fatal("expected an error for a missing column")
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []interface{}{int32(0), int32(1), int32(2), nil, int32(9), int32(10), int32(5)},
+		"B": []string{"a", "b", "c", "d", "e", "f", "g"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	histDf, err := df.Histogram("A", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer histDf.Release()
+
+	// The last bin holds its end so 10 is counted.
+	got := histDf.Display(-1)
+	want := `rec[0]["start"]: [0 2.5 5 7.5]
+rec[0]["end"]: [2.5 5 7.5 10]
+rec[0]["count"]: [3 0 1 2]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	if _, err := df.Histogram("B", 4); err == nil {
+		t.Fatal("expected an error for a string column")
+	}
+	if _, err := df.Histogram("A", 0); err == nil {
+		t.Fatal("expected an error for no bins")
+	}
+
+	// Infinite values are not counted, like NaN.
+	infDf, err := NewDataFrameFromMem(pool, Dict{
+		"A": []float64{math.Inf(-1), 0, 1, math.NaN(), 4, math.Inf(1)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer infDf.Release()
+
+	histDf, err = infDf.Histogram("A", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer histDf.Release()
+
+	got = histDf.Display(-1)
+	want = `rec[0]["start"]: [0 2]
+rec[0]["end"]: [2 4]
+rec[0]["count"]: [2 1]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
+
+func TestValueCountsNaN(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	nan := math.NaN()
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []float64{1, nan, nan, 1, nan},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	countsDf, err := df.ValueCounts("A", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer countsDf.Release()
+
+	got := countsDf.Display(-1)
+	want := `rec[0]["A"]: [NaN 1]
+rec[0]["count"]: [3 2]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	uniqueDf, err := df.Unique("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer uniqueDf.Release()
+	if got, want := uniqueDf.Display(-1), "rec[0][\"A\"]: [1 NaN]\n"; got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/metadata"
+)
+
+// Categorize creates a new DataFrame with the named string column dictionary-encoded.
+// The dictionary holds the distinct non-null values of the column in sorted order.
+func (m *Mutator) Categorize(name string) MutationFunc {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		i := df.columnIndex(name)
+		if i < 0 {
+			return nil, fmt.Errorf("dataframe/dictionary: column %q is not in DataFrame: (%v)", name, df.ColumnNames())
+		}
+		col := df.ColumnAt(i)
+		if metadata.DictionaryTypeMetadataExists(col.Field().Metadata) {
+			return nil, fmt.Errorf("dataframe/dictionary: column %q is already dictionary-encoded", name)
+		}
+		if !arrow.TypeEqual(col.DataType(), arrow.BinaryTypes.String) {
+			return nil, fmt.Errorf("dataframe/dictionary: cannot categorize column %q of type %s", name, col.DataType())
+		}
+
+		chunks := col.Data().Chunks()
+
+		// Find the distinct values to build the dictionary.
+		set := make(map[string]struct{})
+		for _, chunk := range chunks {
+			arr := chunk.(*array.String)
+			for j := 0; j < arr.Len(); j++ {
+				if arr.IsValid(j) {
+					set[arr.Value(j)] = struct{}{}
+				}
+			}
+		}
+		dictionary := make([]string, 0, len(set))
+		for value := range set {
+			dictionary = append(dictionary, value)
+		}
+		sort.Strings(dictionary)
+		lookup := make(map[string]int32, len(dictionary))
+		for idx, value := range dictionary {
+			lookup[value] = int32(idx)
+		}
+
+		// Encode each chunk, keeping the chunk layout of the original column.
+		indices := make([]array.Interface, len(chunks))
+		defer func() {
+			for j := range indices {
+				if indices[j] != nil {
+					indices[j].Release()
+				}
+			}
+		}()
+		for j, chunk := range chunks {
+			arr := chunk.(*array.String)
+			func() {
+				bldr := array.NewInt32Builder(m.mem)
+				defer bldr.Release()
+				bldr.Reserve(arr.Len())
+				for k := 0; k < arr.Len(); k++ {
+					if arr.IsNull(k) {
+						bldr.AppendNull()
+						continue
+					}
+					bldr.Append(lookup[arr.Value(k)])
+				}
+				indices[j] = bldr.NewArray()
+			}()
+		}
+
+		field := col.Field()
+		field.Type = metadata.DictionaryIndexType
+		field.Metadata = metadata.AppendDictionaryTypeMetadata(field.Metadata, dictionary)
+
+		return df.replaceColumnFromChunks(i, field, indices)
+	})
+}
+
+// Decategorize creates a new DataFrame with the named dictionary-encoded column
+// converted back into a string column.
+func (m *Mutator) Decategorize(name string) MutationFunc {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		i := df.columnIndex(name)
+		if i < 0 {
+			return nil, fmt.Errorf("dataframe/dictionary: column %q is not in DataFrame: (%v)", name, df.ColumnNames())
+		}
+		col := df.ColumnAt(i)
+		dictionary, err := metadata.DictionaryValues(col.Field().Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("dataframe/dictionary: column %q: %w", name, err)
+		}
+
+		chunks := col.Data().Chunks()
+		values := make([]array.Interface, len(chunks))
+		defer func() {
+			for j := range values {
+				if values[j] != nil {
+					values[j].Release()
+				}
+			}
+		}()
+		for j, chunk := range chunks {
+			arr := chunk.(*array.Int32)
+			func() {
+				bldr := array.NewStringBuilder(m.mem)
+				defer bldr.Release()
+				bldr.Reserve(arr.Len())
+				for k := 0; k < arr.Len(); k++ {
+					if arr.IsNull(k) {
+						bldr.AppendNull()
+						continue
+					}
+					bldr.Append(dictionary[arr.Value(k)])
+				}
+				values[j] = bldr.NewArray()
+			}()
+		}
+
+		field := col.Field()
+		field.Type = arrow.BinaryTypes.String
+		field.Metadata = metadata.RemoveDictionaryTypeMetadata(field.Metadata)
+
+		return df.replaceColumnFromChunks(i, field, values)
+	})
+}
+
+// dictionaryIndices returns a Column over the indices of a dictionary-encoded column.
+// The returned Column must be released.
+func dictionaryIndices(col *array.Column) *array.Column {
+	field := col.Field()
+	field.Metadata = metadata.RemoveDictionaryTypeMetadata(field.Metadata)
+	return array.NewColumn(field, col.Data())
+}
+
+// mergeDictionaries builds the field for a join key column whose
+// left and right columns are not encoded with the same dictionary.
+// When both are dictionary-encoded the dictionaries are merged,
+// otherwise the key column is decoded into a string column.
+func mergeDictionaries(left, right arrow.Field) (arrow.Field, error) {
+	field := left
+	if !metadata.DictionaryTypeMetadataExists(left.Metadata) || !metadata.DictionaryTypeMetadataExists(right.Metadata) {
+		field.Type = arrow.BinaryTypes.String
+		field.Metadata = metadata.RemoveDictionaryTypeMetadata(left.Metadata)
+		return field, nil
+	}
+
+	leftValues, err := metadata.DictionaryValues(left.Metadata)
+	if err != nil {
+		return field, err
+	}
+	rightValues, err := metadata.DictionaryValues(right.Metadata)
+	if err != nil {
+		return field, err
+	}
+
+	seen := make(map[string]struct{}, len(leftValues))
+	merged := make([]string, 0, len(leftValues)+len(rightValues))
+	for _, value := range leftValues {
+		seen[value] = struct{}{}
+		merged = append(merged, value)
+	}
+	for _, value := range rightValues {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		merged = append(merged, value)
+	}
+
+	field.Metadata = metadata.AppendDictionaryTypeMetadata(metadata.RemoveDictionaryTypeMetadata(left.Metadata), merged)
+	return field, nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/metadata"
+)
+
+func TestCategorize(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []int32{1, 2, 3, 4, 5},
+		"B": []interface{}{"red", "blue", nil, "red", "green"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	catDf, err := df.Categorize("B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer catDf.Release()
+
+	field := catDf.Column("B").Field()
+	if got, want := field.Type, arrow.DataType(arrow.PrimitiveTypes.Int32); !arrow.TypeEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	dictionary, err := metadata.DictionaryValues(field.Metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := dictionary, []string{"blue", "green", "red"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	got := catDf.Display(-1)
+	want := `rec[0]["A"]: [1 2 3 4 5]
+rec[0]["B"]: [2 0 (null) 2 1]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	var b bytes.Buffer
+	if err := catDf.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	wantJSON := `{"A":1,"B":"red"}
+{"A":2,"B":"blue"}
+{"A":3,"B":null}
+{"A":4,"B":"red"}
+{"A":5,"B":"green"}
+`
+	if got := b.String(); got != wantJSON {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, wantJSON)
+	}
+
+	if _, err := catDf.Categorize("B"); err == nil {
+		t.Fatal("expected an error categorizing a dictionary-encoded column")
+	}
+	if _, err := catDf.Categorize("A"); err == nil {
+		t.Fatal("expected an error categorizing a non-string column")
+	}
+
+	decatDf, err := catDf.Decategorize("B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer decatDf.Release()
+
+	if !decatDf.Equals(df) {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", decatDf.Display(-1), df.Display(-1))
+	}
+}
+
+func TestCategorizeEquals(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	build := func(values []interface{}) *DataFrame {
+		df, err := NewDataFrameFromMem(pool, Dict{
+			"A": values,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer df.Release()
+		catDf, err := df.Categorize("A")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return catDf
+	}
+
+	df1 := build([]interface{}{"a", "b", nil, "a"})
+	defer df1.Release()
+	df2 := build([]interface{}{"a", "b", nil, "a"})
+	defer df2.Release()
+	df3 := build([]interface{}{"a", "b", nil, "b"})
+	defer df3.Release()
+
+	if !df1.Equals(df2) {
+		t.Fatal("expected DataFrames sharing a dictionary to be equal")
+	}
+	if df1.Equals(df3) {
+		t.Fatal("expected DataFrames with different values to not be equal")
+	}
+}
+
+func TestCategorizeJoin(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	leftDf, err := NewDataFrameFromMem(pool, Dict{
+		"A": []string{"x", "y", "z", "x"},
+		"B": []int64{1, 2, 3, 4},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer leftDf.Release()
+
+	rightDf, err := NewDataFrameFromMem(pool, Dict{
+		"A": []string{"z", "x", "y", "w"},
+		"C": []int64{10, 20, 30, 40},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rightDf.Release()
+
+	leftCatDf, err := leftDf.Categorize("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer leftCatDf.Release()
+
+	rightCatDf, err := rightDf.Categorize("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rightCatDf.Release()
+
+	t.Run("different dictionaries", func(t *testing.T) {
+		joinedDf, err := leftCatDf.OuterJoin(rightCatDf, []string{"A"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer joinedDf.Release()
+
+		dictionary, err := metadata.DictionaryValues(joinedDf.Column("A").Field().Metadata)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := dictionary, []string{"x", "y", "z", "w"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+
+		got := joinedDf.Display(-1)
+		want := `rec[0]["A"]: [0 1 2 0 3]
+rec[0]["B"]: [1 2 3 4 (null)]
+rec[0]["C"]: [20 30 10 20 40]
+`
+		if got != want {
+			t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+		}
+	})
+
+	t.Run("shared dictionary", func(t *testing.T) {
+		sliceDf, err := leftCatDf.Slice(1, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer sliceDf.Release()
+
+		joinedDf, err := leftCatDf.InnerJoin(sliceDf, []string{"A"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer joinedDf.Release()
+
+		if got, want := joinedDf.Column("A").Field(), leftCatDf.Column("A").Field(); !got.Equal(want) {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+
+		got := joinedDf.Display(-1)
+		want := `rec[0]["A"]: [1 2]
+rec[0]["B_0"]: [2 3]
+rec[0]["B_1"]: [2 3]
+`
+		if got != want {
+			t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+		}
+	})
+
+	t.Run("plain strings", func(t *testing.T) {
+		joinedDf, err := leftCatDf.InnerJoin(rightDf, []string{"A"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer joinedDf.Release()
+
+		got := joinedDf.Display(-1)
+		want := `rec[0]["A"]: ["x" "y" "z" "x"]
+rec[0]["B"]: [1 2 3 4]
+rec[0]["C"]: [20 30 10 20]
+`
+		if got != want {
+			t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+		}
+	})
+}
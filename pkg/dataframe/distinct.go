@@ -0,0 +1,259 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/gomem/gomem/pkg/iterator"
+)
+
+// Keep is which of the duplicate rows DropDuplicates keeps.
+type Keep int
+
+const (
+	// KeepFirst keeps the first of the duplicate rows.
+	KeepFirst Keep = iota
+	// KeepLast keeps the last of the duplicate rows.
+	KeepLast
+	// KeepNone drops all of the duplicate rows.
+	KeepNone
+)
+
+// dropDuplicatesConfig are the config params for DropDuplicates.
+type dropDuplicatesConfig struct {
+	keep          Keep
+	nullsDistinct bool
+}
+
+// WithKeep configures DropDuplicates to keep the given row of each set of duplicates.
+// The default is KeepFirst.
+func WithKeep(keep Keep) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*dropDuplicatesConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithKeep to: %T", p)
+		}
+		o.keep = keep
+		return nil
+	}
+}
+
+// WithNullsDistinct configures DropDuplicates to treat nil elements as unknown so nil != nil,
+// like the joins do. Rows with a nil in any of the compared columns are then never duplicates.
+// By default nil elements are equal to each other.
+func WithNullsDistinct() Option {
+	return func(p interface{}) error {
+		o, ok := p.(*dropDuplicatesConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithNullsDistinct to: %T", p)
+		}
+		o.nullsDistinct = true
+		return nil
+	}
+}
+
+// Distinct returns a DataFrame without the rows that duplicate an earlier row in the subset columns.
+// All the columns are compared when subset is empty and nil elements are equal to each other.
+func (m *Mutator) Distinct(subset ...string) MutationFunc {
+	return m.DropDuplicates(subset)
+}
+
+// DropDuplicates returns a DataFrame without the rows that duplicate another row in the subset columns.
+// All the columns are compared when subset is empty. The rows that are kept stay in their original order.
+// Use WithKeep to choose which of the duplicates is kept and WithNullsDistinct to change how nil elements compare.
+func (m *Mutator) DropDuplicates(subset []string, opts ...Option) MutationFunc {
+	cfg := &dropDuplicatesConfig{}
+	var err error
+	for _, opt := range opts {
+		if err = opt(cfg); err != nil {
+			break
+		}
+	}
+
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		cols := df.Columns()
+		if len(subset) > 0 {
+			for _, name := range subset {
+				if df.Column(name) == nil {
+					return nil, fmt.Errorf("bullseye/distinct: column %s is not in DataFrame: (%v)", name, df.ColumnNames())
+				}
+			}
+			cols = df.SelectColumns(subset...)
+		}
+
+		keep, err := duplicateRows(cols, df.NumRows(), cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		indices := make([]int64, 0, len(keep))
+		for i, ok := range keep {
+			if ok {
+				indices = append(indices, int64(i))
+			}
+		}
+		return m.take(df, indices)
+	})
+}
+
+// duplicateRows returns whether each row should be kept, keying each row by the values of cols.
+func duplicateRows(cols []array.Column, rows int64, cfg *dropDuplicatesConfig) ([]bool, error) {
+	keep := make([]bool, rows)
+	// first holds the first row of each set of duplicates and count how many rows are in it.
+	first := make(map[string]int)
+	count := make(map[string]int)
+	keys := make([]string, rows)
+
+	it := iterator.NewStepIteratorForColumns(cols)
+	defer it.Release()
+	for i := 0; it.Next(); i++ {
+		stepValue := it.Values()
+		if cfg.nullsDistinct && hasNil(stepValue.Values) {
+			// This row can't equal any other row so it is always kept.
+			keep[i] = true
+			continue
+		}
+
+		key, err := rowKey(it, stepValue)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+
+		if _, ok := first[key]; !ok {
+			first[key] = i
+		}
+		count[key]++
+	}
+
+	// last holds the last row of each set of duplicates.
+	last := make(map[string]int, len(first))
+	for i, key := range keys {
+		if _, ok := count[key]; ok {
+			last[key] = i
+		}
+	}
+
+	for i, key := range keys {
+		n, ok := count[key]
+		if !ok {
+			continue
+		}
+		switch cfg.keep {
+		case KeepFirst:
+			keep[i] = first[key] == i
+		case KeepLast:
+			keep[i] = last[key] == i
+		case KeepNone:
+			keep[i] = n == 1
+		}
+	}
+
+	return keep, nil
+}
+
+func hasNil(values []interface{}) bool {
+	for _, v := range values {
+		if v == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// rowKey returns a key that is the same for rows with equal values.
+// Every value is written with its type and length so values can't run into each other.
+// Nested values are keyed by their JSON representation, which is only computed for rows that have some.
+func rowKey(it iterator.StepIterator, stepValue *iterator.StepValue) (string, error) {
+	var b strings.Builder
+	var valuesJSON []interface{}
+	for i, v := range stepValue.Values {
+		switch v.(type) {
+		case iterator.ValueIterator, []iterator.ValueIterator, map[interface{}]interface{}:
+			if valuesJSON == nil {
+				jsonValue, err := it.ValuesJSON()
+				if err != nil {
+					return "", err
+				}
+				valuesJSON = jsonValue.ValuesJSON
+			}
+			v = valuesJSON[i]
+		}
+		writeKeyValue(&b, v)
+	}
+	return b.String(), nil
+}
+
+// writeKeyValue writes v to b. All NaN values are written the same, as are 0 and -0.
+func writeKeyValue(b *strings.Builder, v interface{}) {
+	switch t := v.(type) {
+	case nil:
+		b.WriteString("nil;")
+		return
+	case float16.Num:
+		v = float64(t.Float32())
+	case float32:
+		v = float64(t)
+	case []byte:
+		v = string(t)
+	case []interface{}:
+		fmt.Fprintf(b, "[%d:", len(t))
+		for _, elem := range t {
+			writeKeyValue(b, elem)
+		}
+		b.WriteString("]")
+		return
+	case map[string]interface{}:
+		names := make([]string, 0, len(t))
+		for name := range t {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintf(b, "{%d:", len(t))
+		for _, name := range names {
+			writeKeyValue(b, name)
+			writeKeyValue(b, t[name])
+		}
+		b.WriteString("}")
+		return
+	}
+
+	var s string
+	switch t := v.(type) {
+	case float64:
+		switch {
+		case math.IsNaN(t):
+			s = "NaN"
+		case t == 0:
+			s = "0"
+		default:
+			s = strconv.FormatFloat(t, 'g', -1, 64)
+		}
+	default:
+		s = fmt.Sprint(t)
+	}
+	fmt.Fprintf(b, "%T%d:%s;", v, len(s), s)
+}
@@ -58,7 +58,8 @@ type Element interface {
 func CastElement(dtype arrow.DataType, v interface{}) Element {
 	switch dtype.(type) {
 	// case *arrow.NullType: // TODO: implement
-	// case *arrow.BooleanType: // TODO: implement
+	case *arrow.BooleanType:
+		return NewBooleanElement(v)
 	case *arrow.Uint8Type:
 		return NewUint8Element(v)
 	case *arrow.Int8Type:
@@ -84,8 +85,16 @@ func CastElement(dtype arrow.DataType, v interface{}) Element {
 		return NewDate32Element(v)
 	case *arrow.Date64Type:
 		return NewDate64Element(v)
-		// case *arrow.StringType: // TODO: implement
-
+	case *arrow.Time32Type:
+		return NewTime32Element(v)
+	case *arrow.Time64Type:
+		return NewTime64Element(v)
+	case *arrow.TimestampType:
+		return NewTimestampElement(v)
+	case *arrow.DurationType:
+		return NewDurationElement(v)
+	case *arrow.StringType:
+		return NewStringElement(v)
 	}
 	panic(fmt.Errorf("bullseye/element: unsupported element for %T", dtype))
 }
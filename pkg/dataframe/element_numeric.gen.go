@@ -211,25 +211,33 @@ func (e Date32Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Date32Element
 // is less than the right Date32Element.
 func (e Date32Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Date32")
+	return e.compare(r, func(left, right arrow.Date32) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Date32Element
 // is less than or equal to the right Date32Element.
 func (e Date32Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Date32")
+	return e.compare(r, func(left, right arrow.Date32) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Date32Element
 // is greter than the right Date32Element.
 func (e Date32Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Date32")
+	return e.compare(r, func(left, right arrow.Date32) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Date32Element
 // is greter than or equal to the right Date32Element.
 func (e Date32Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Date32")
+	return e.compare(r, func(left, right arrow.Date32) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -324,25 +332,33 @@ func (e Date64Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Date64Element
 // is less than the right Date64Element.
 func (e Date64Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Date64")
+	return e.compare(r, func(left, right arrow.Date64) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Date64Element
 // is less than or equal to the right Date64Element.
 func (e Date64Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Date64")
+	return e.compare(r, func(left, right arrow.Date64) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Date64Element
 // is greter than the right Date64Element.
 func (e Date64Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Date64")
+	return e.compare(r, func(left, right arrow.Date64) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Date64Element
 // is greter than or equal to the right Date64Element.
 func (e Date64Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Date64")
+	return e.compare(r, func(left, right arrow.Date64) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -437,33 +453,25 @@ func (e DayTimeIntervalElement) Neq(r Element) (bool, error) {
 // Less returns true if the left DayTimeIntervalElement
 // is less than the right DayTimeIntervalElement.
 func (e DayTimeIntervalElement) Less(r Element) (bool, error) {
-	return e.compare(r, func(left, right arrow.DayTimeInterval) bool {
-		return left.Days < right.Days && left.Milliseconds < right.Milliseconds
-	})
+	return false, errors.New("operator < not defined on DayTimeInterval")
 }
 
 // LessEq returns true if the left DayTimeIntervalElement
 // is less than or equal to the right DayTimeIntervalElement.
 func (e DayTimeIntervalElement) LessEq(r Element) (bool, error) {
-	return e.compare(r, func(left, right arrow.DayTimeInterval) bool {
-		return left.Days <= right.Days && left.Milliseconds <= right.Milliseconds
-	})
+	return false, errors.New("operator <= not defined on DayTimeInterval")
 }
 
 // Greater returns true if the left DayTimeIntervalElement
 // is greter than the right DayTimeIntervalElement.
 func (e DayTimeIntervalElement) Greater(r Element) (bool, error) {
-	return e.compare(r, func(left, right arrow.DayTimeInterval) bool {
-		return left.Days > right.Days && left.Milliseconds > right.Milliseconds
-	})
+	return false, errors.New("operator > not defined on DayTimeInterval")
 }
 
 // GreaterEq returns true if the left DayTimeIntervalElement
 // is greter than or equal to the right DayTimeIntervalElement.
 func (e DayTimeIntervalElement) GreaterEq(r Element) (bool, error) {
-	return e.compare(r, func(left, right arrow.DayTimeInterval) bool {
-		return left.Days >= right.Days && left.Milliseconds >= right.Milliseconds
-	})
+	return false, errors.New("operator >= not defined on DayTimeInterval")
 }
 
 // Accessor/conversion methods
@@ -679,25 +687,33 @@ func (e DurationElement) Neq(r Element) (bool, error) {
 // Less returns true if the left DurationElement
 // is less than the right DurationElement.
 func (e DurationElement) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Duration")
+	return e.compare(r, func(left, right arrow.Duration) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left DurationElement
 // is less than or equal to the right DurationElement.
 func (e DurationElement) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Duration")
+	return e.compare(r, func(left, right arrow.Duration) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left DurationElement
 // is greter than the right DurationElement.
 func (e DurationElement) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Duration")
+	return e.compare(r, func(left, right arrow.Duration) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left DurationElement
 // is greter than or equal to the right DurationElement.
 func (e DurationElement) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Duration")
+	return e.compare(r, func(left, right arrow.Duration) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -767,7 +783,7 @@ func (e Float16Element) Eq(r Element) (bool, error) {
 		return false, nil
 	}
 	return e.compare(r, func(left, right float16.Num) bool {
-		return left.Uint16() == right.Uint16()
+		return left.Float32() == right.Float32()
 	})
 }
 
@@ -778,7 +794,7 @@ func (e Float16Element) EqStrict(r Element) (bool, error) {
 		return true, nil
 	}
 	return e.compare(r, func(left, right float16.Num) bool {
-		return left.Uint16() == right.Uint16()
+		return left.Float32() == right.Float32()
 	})
 }
 
@@ -793,7 +809,7 @@ func (e Float16Element) Neq(r Element) (bool, error) {
 // is less than the right Float16Element.
 func (e Float16Element) Less(r Element) (bool, error) {
 	return e.compare(r, func(left, right float16.Num) bool {
-		return left.Uint16() < right.Uint16()
+		return left.Float32() < right.Float32()
 	})
 }
 
@@ -801,7 +817,7 @@ func (e Float16Element) Less(r Element) (bool, error) {
 // is less than or equal to the right Float16Element.
 func (e Float16Element) LessEq(r Element) (bool, error) {
 	return e.compare(r, func(left, right float16.Num) bool {
-		return left.Uint16() <= right.Uint16()
+		return left.Float32() <= right.Float32()
 	})
 }
 
@@ -809,7 +825,7 @@ func (e Float16Element) LessEq(r Element) (bool, error) {
 // is greter than the right Float16Element.
 func (e Float16Element) Greater(r Element) (bool, error) {
 	return e.compare(r, func(left, right float16.Num) bool {
-		return left.Uint16() > right.Uint16()
+		return left.Float32() > right.Float32()
 	})
 }
 
@@ -817,7 +833,7 @@ func (e Float16Element) Greater(r Element) (bool, error) {
 // is greter than or equal to the right Float16Element.
 func (e Float16Element) GreaterEq(r Element) (bool, error) {
 	return e.compare(r, func(left, right float16.Num) bool {
-		return left.Uint16() >= right.Uint16()
+		return left.Float32() >= right.Float32()
 	})
 }
 
@@ -913,25 +929,33 @@ func (e Float32Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Float32Element
 // is less than the right Float32Element.
 func (e Float32Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Float32")
+	return e.compare(r, func(left, right float32) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Float32Element
 // is less than or equal to the right Float32Element.
 func (e Float32Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Float32")
+	return e.compare(r, func(left, right float32) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Float32Element
 // is greter than the right Float32Element.
 func (e Float32Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Float32")
+	return e.compare(r, func(left, right float32) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Float32Element
 // is greter than or equal to the right Float32Element.
 func (e Float32Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Float32")
+	return e.compare(r, func(left, right float32) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1026,25 +1050,33 @@ func (e Float64Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Float64Element
 // is less than the right Float64Element.
 func (e Float64Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Float64")
+	return e.compare(r, func(left, right float64) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Float64Element
 // is less than or equal to the right Float64Element.
 func (e Float64Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Float64")
+	return e.compare(r, func(left, right float64) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Float64Element
 // is greter than the right Float64Element.
 func (e Float64Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Float64")
+	return e.compare(r, func(left, right float64) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Float64Element
 // is greter than or equal to the right Float64Element.
 func (e Float64Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Float64")
+	return e.compare(r, func(left, right float64) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1139,25 +1171,33 @@ func (e Int16Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Int16Element
 // is less than the right Int16Element.
 func (e Int16Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Int16")
+	return e.compare(r, func(left, right int16) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Int16Element
 // is less than or equal to the right Int16Element.
 func (e Int16Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Int16")
+	return e.compare(r, func(left, right int16) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Int16Element
 // is greter than the right Int16Element.
 func (e Int16Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Int16")
+	return e.compare(r, func(left, right int16) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Int16Element
 // is greter than or equal to the right Int16Element.
 func (e Int16Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Int16")
+	return e.compare(r, func(left, right int16) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1252,25 +1292,33 @@ func (e Int32Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Int32Element
 // is less than the right Int32Element.
 func (e Int32Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Int32")
+	return e.compare(r, func(left, right int32) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Int32Element
 // is less than or equal to the right Int32Element.
 func (e Int32Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Int32")
+	return e.compare(r, func(left, right int32) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Int32Element
 // is greter than the right Int32Element.
 func (e Int32Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Int32")
+	return e.compare(r, func(left, right int32) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Int32Element
 // is greter than or equal to the right Int32Element.
 func (e Int32Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Int32")
+	return e.compare(r, func(left, right int32) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1365,25 +1413,33 @@ func (e Int64Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Int64Element
 // is less than the right Int64Element.
 func (e Int64Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Int64")
+	return e.compare(r, func(left, right int64) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Int64Element
 // is less than or equal to the right Int64Element.
 func (e Int64Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Int64")
+	return e.compare(r, func(left, right int64) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Int64Element
 // is greter than the right Int64Element.
 func (e Int64Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Int64")
+	return e.compare(r, func(left, right int64) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Int64Element
 // is greter than or equal to the right Int64Element.
 func (e Int64Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Int64")
+	return e.compare(r, func(left, right int64) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1478,25 +1534,33 @@ func (e Int8Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Int8Element
 // is less than the right Int8Element.
 func (e Int8Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Int8")
+	return e.compare(r, func(left, right int8) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Int8Element
 // is less than or equal to the right Int8Element.
 func (e Int8Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Int8")
+	return e.compare(r, func(left, right int8) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Int8Element
 // is greter than the right Int8Element.
 func (e Int8Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Int8")
+	return e.compare(r, func(left, right int8) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Int8Element
 // is greter than or equal to the right Int8Element.
 func (e Int8Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Int8")
+	return e.compare(r, func(left, right int8) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1591,25 +1655,33 @@ func (e MonthIntervalElement) Neq(r Element) (bool, error) {
 // Less returns true if the left MonthIntervalElement
 // is less than the right MonthIntervalElement.
 func (e MonthIntervalElement) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on MonthInterval")
+	return e.compare(r, func(left, right arrow.MonthInterval) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left MonthIntervalElement
 // is less than or equal to the right MonthIntervalElement.
 func (e MonthIntervalElement) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on MonthInterval")
+	return e.compare(r, func(left, right arrow.MonthInterval) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left MonthIntervalElement
 // is greter than the right MonthIntervalElement.
 func (e MonthIntervalElement) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on MonthInterval")
+	return e.compare(r, func(left, right arrow.MonthInterval) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left MonthIntervalElement
 // is greter than or equal to the right MonthIntervalElement.
 func (e MonthIntervalElement) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on MonthInterval")
+	return e.compare(r, func(left, right arrow.MonthInterval) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1704,25 +1776,33 @@ func (e StringElement) Neq(r Element) (bool, error) {
 // Less returns true if the left StringElement
 // is less than the right StringElement.
 func (e StringElement) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on String")
+	return e.compare(r, func(left, right string) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left StringElement
 // is less than or equal to the right StringElement.
 func (e StringElement) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on String")
+	return e.compare(r, func(left, right string) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left StringElement
 // is greter than the right StringElement.
 func (e StringElement) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on String")
+	return e.compare(r, func(left, right string) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left StringElement
 // is greter than or equal to the right StringElement.
 func (e StringElement) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on String")
+	return e.compare(r, func(left, right string) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1817,25 +1897,33 @@ func (e Time32Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Time32Element
 // is less than the right Time32Element.
 func (e Time32Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Time32")
+	return e.compare(r, func(left, right arrow.Time32) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Time32Element
 // is less than or equal to the right Time32Element.
 func (e Time32Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Time32")
+	return e.compare(r, func(left, right arrow.Time32) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Time32Element
 // is greter than the right Time32Element.
 func (e Time32Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Time32")
+	return e.compare(r, func(left, right arrow.Time32) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Time32Element
 // is greter than or equal to the right Time32Element.
 func (e Time32Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Time32")
+	return e.compare(r, func(left, right arrow.Time32) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -1930,25 +2018,33 @@ func (e Time64Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Time64Element
 // is less than the right Time64Element.
 func (e Time64Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Time64")
+	return e.compare(r, func(left, right arrow.Time64) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Time64Element
 // is less than or equal to the right Time64Element.
 func (e Time64Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Time64")
+	return e.compare(r, func(left, right arrow.Time64) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Time64Element
 // is greter than the right Time64Element.
 func (e Time64Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Time64")
+	return e.compare(r, func(left, right arrow.Time64) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Time64Element
 // is greter than or equal to the right Time64Element.
 func (e Time64Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Time64")
+	return e.compare(r, func(left, right arrow.Time64) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -2043,25 +2139,33 @@ func (e TimestampElement) Neq(r Element) (bool, error) {
 // Less returns true if the left TimestampElement
 // is less than the right TimestampElement.
 func (e TimestampElement) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Timestamp")
+	return e.compare(r, func(left, right arrow.Timestamp) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left TimestampElement
 // is less than or equal to the right TimestampElement.
 func (e TimestampElement) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Timestamp")
+	return e.compare(r, func(left, right arrow.Timestamp) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left TimestampElement
 // is greter than the right TimestampElement.
 func (e TimestampElement) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Timestamp")
+	return e.compare(r, func(left, right arrow.Timestamp) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left TimestampElement
 // is greter than or equal to the right TimestampElement.
 func (e TimestampElement) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Timestamp")
+	return e.compare(r, func(left, right arrow.Timestamp) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -2156,25 +2260,33 @@ func (e Uint16Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Uint16Element
 // is less than the right Uint16Element.
 func (e Uint16Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Uint16")
+	return e.compare(r, func(left, right uint16) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Uint16Element
 // is less than or equal to the right Uint16Element.
 func (e Uint16Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Uint16")
+	return e.compare(r, func(left, right uint16) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Uint16Element
 // is greter than the right Uint16Element.
 func (e Uint16Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Uint16")
+	return e.compare(r, func(left, right uint16) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Uint16Element
 // is greter than or equal to the right Uint16Element.
 func (e Uint16Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Uint16")
+	return e.compare(r, func(left, right uint16) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -2269,25 +2381,33 @@ func (e Uint32Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Uint32Element
 // is less than the right Uint32Element.
 func (e Uint32Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Uint32")
+	return e.compare(r, func(left, right uint32) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Uint32Element
 // is less than or equal to the right Uint32Element.
 func (e Uint32Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Uint32")
+	return e.compare(r, func(left, right uint32) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Uint32Element
 // is greter than the right Uint32Element.
 func (e Uint32Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Uint32")
+	return e.compare(r, func(left, right uint32) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Uint32Element
 // is greter than or equal to the right Uint32Element.
 func (e Uint32Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Uint32")
+	return e.compare(r, func(left, right uint32) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -2382,25 +2502,33 @@ func (e Uint64Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Uint64Element
 // is less than the right Uint64Element.
 func (e Uint64Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Uint64")
+	return e.compare(r, func(left, right uint64) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Uint64Element
 // is less than or equal to the right Uint64Element.
 func (e Uint64Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Uint64")
+	return e.compare(r, func(left, right uint64) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Uint64Element
 // is greter than the right Uint64Element.
 func (e Uint64Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Uint64")
+	return e.compare(r, func(left, right uint64) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Uint64Element
 // is greter than or equal to the right Uint64Element.
 func (e Uint64Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Uint64")
+	return e.compare(r, func(left, right uint64) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -2495,25 +2623,33 @@ func (e Uint8Element) Neq(r Element) (bool, error) {
 // Less returns true if the left Uint8Element
 // is less than the right Uint8Element.
 func (e Uint8Element) Less(r Element) (bool, error) {
-	return false, errors.New("operator < not defined on Uint8")
+	return e.compare(r, func(left, right uint8) bool {
+		return left < right
+	})
 }
 
 // LessEq returns true if the left Uint8Element
 // is less than or equal to the right Uint8Element.
 func (e Uint8Element) LessEq(r Element) (bool, error) {
-	return false, errors.New("operator <= not defined on Uint8")
+	return e.compare(r, func(left, right uint8) bool {
+		return left <= right
+	})
 }
 
 // Greater returns true if the left Uint8Element
 // is greter than the right Uint8Element.
 func (e Uint8Element) Greater(r Element) (bool, error) {
-	return false, errors.New("operator > not defined on Uint8")
+	return e.compare(r, func(left, right uint8) bool {
+		return left > right
+	})
 }
 
 // GreaterEq returns true if the left Uint8Element
 // is greter than or equal to the right Uint8Element.
 func (e Uint8Element) GreaterEq(r Element) (bool, error) {
-	return false, errors.New("operator >= not defined on Uint8")
+	return e.compare(r, func(left, right uint8) bool {
+		return left >= right
+	})
 }
 
 // Accessor/conversion methods
@@ -0,0 +1,82 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"io"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/iterator"
+)
+
+// ToIPC writes the DataFrame to w in the Arrow IPC stream format, including the schema and field metadata.
+// The DataFrame is written as one record batch for each run of rows that are in the same chunk in every column.
+func (df *DataFrame) ToIPC(w io.Writer) error {
+	rr, err := iterator.NewRecordReaderForColumns(df.Columns())
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+
+	writer := ipc.NewWriter(w, ipc.WithSchema(df.schema), ipc.WithAllocator(df.mem))
+	for rr.Next() {
+		if err := writer.Write(rr.Record()); err != nil {
+			writer.Close()
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+// NewDataFrameFromIPC reads a DataFrame from r in the Arrow IPC stream format.
+// Each record batch becomes a chunk of the columns.
+func NewDataFrameFromIPC(mem memory.Allocator, r io.Reader) (*DataFrame, error) {
+	reader, err := ipc.NewReader(r, ipc.WithAllocator(mem))
+	if err != nil {
+		return nil, err
+	}
+	// The Reader of the Arrow version we depend on starts without a reference,
+	// so it takes one to be able to release what it holds.
+	reader.Retain()
+	defer reader.Release()
+
+	var records []array.Record
+	defer func() {
+		for i := range records {
+			records[i].Release()
+		}
+	}()
+	for reader.Next() {
+		rec := reader.Record()
+		rec.Retain()
+		records = append(records, rec)
+	}
+	if err := reader.Err(); err != nil {
+		return nil, err
+	}
+
+	schema := reader.Schema()
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	df, err := NewDataFrameFromTable(mem, tbl)
+	if err != nil {
+		return nil, err
+	}
+	df.setMetadata(schema.Metadata())
+	return df, nil
+}
@@ -18,61 +18,141 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"reflect"
 
-	"github.com/gomem/gomem/pkg/metadata"
 	"github.com/gomem/gomem/pkg/iterator"
 )
 
+// Orient is the layout ToJSON writes the DataFrame in.
+// They are named after the orient values of Pandas to_json.
+type Orient int
+
+const (
+	// OrientRecordLines writes newline delimited JSON with each line as a single record:
+	// {"a":1,"b":"x"}
+	// {"a":2,"b":"y"}
+	OrientRecordLines Orient = iota
+	// OrientRecords writes a JSON array of records: [{"a":1,"b":"x"},{"a":2,"b":"y"}]
+	OrientRecords
+	// OrientColumns writes an object of the values for each column: {"a":[1,2],"b":["x","y"]}
+	OrientColumns
+	// OrientSplit writes an object of the column names and the values for each row:
+	// {"columns":["a","b"],"data":[[1,"x"],[2,"y"]]}
+	OrientSplit
+	// OrientValues writes an array of the values for each row: [[1,"x"],[2,"y"]]
+	OrientValues
+)
+
+type toJSONConfig struct {
+	jsonOptions *iterator.JSONOptions
+	orient      Orient
+}
+
+// WithJSONOptions configures ToJSON to represent the values as described by opts.
+func WithJSONOptions(opts *iterator.JSONOptions) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*toJSONConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithJSONOptions to: %T", p)
+		}
+		o.jsonOptions = opts
+		return nil
+	}
+}
+
+// WithOrient configures ToJSON to write the DataFrame in the given layout.
+func WithOrient(orient Orient) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*toJSONConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithOrient to: %T", p)
+		}
+		o.orient = orient
+		return nil
+	}
+}
+
 // ToJSON writes the DataFrame as JSON.
-// This will write newline delimited JSON with each line as a single record.
+// By default this will write newline delimited JSON with each line as a single record.
 // This is equivaliant to Pandas to_json when you specify:
 // orient='records' and lines=True.
-func (df *DataFrame) ToJSON(w io.Writer) error {
-	schema := df.Schema()
-	fields := schema.Fields()
-	names := make([]string, len(fields))
-	for i, field := range fields {
-		names[i] = field.Name
+// Use WithOrient to write another layout and WithJSONOptions to configure how the values are represented.
+func (df *DataFrame) ToJSON(w io.Writer, opts ...Option) error {
+	cfg := &toJSONConfig{}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return err
+		}
 	}
-	// TODO(nickpoorman): remove this once Map type is supported in Arrow
-	// https://issues.apache.org/jira/browse/ARROW-5640
-	isMap := make([]bool, len(fields))
-	for i, field := range fields {
-		isMap[i] = metadata.OriginalMapTypeMetadataExists(field.Metadata)
+
+	names := df.ColumnNames()
+
+	switch cfg.orient {
+	case OrientRecordLines:
+		enc := json.NewEncoder(w)
+		return df.eachRowJSON(cfg, func(values []interface{}) error {
+			// At this point everything in values is json.
+			// We just have to build the object from it.
+			jsonObj := make(map[string]interface{})
+			for i, jsonValue := range values {
+				jsonObj[names[i]] = jsonValue
+			}
+			return enc.Encode(jsonObj)
+		})
+
+	case OrientRecords:
+		return writeJSONArray(w, "[", "]\n", func(write func(interface{}) error) error {
+			return df.eachRowJSON(cfg, func(values []interface{}) error {
+				jsonObj := make(map[string]interface{})
+				for i, jsonValue := range values {
+					jsonObj[names[i]] = jsonValue
+				}
+				return write(jsonObj)
+			})
+		})
+
+	case OrientValues:
+		return writeJSONArray(w, "[", "]\n", func(write func(interface{}) error) error {
+			return df.eachRowJSON(cfg, func(values []interface{}) error {
+				return write(values)
+			})
+		})
+
+	case OrientSplit:
+		columns, err := json.Marshal(names)
+		if err != nil {
+			return err
+		}
+		prefix := `{"columns":` + string(columns) + `,"data":[`
+		return writeJSONArray(w, prefix, "]}\n", func(write func(interface{}) error) error {
+			return df.eachRowJSON(cfg, func(values []interface{}) error {
+				return write(values)
+			})
+		})
+
+	case OrientColumns:
+		return df.writeColumnsJSON(w, cfg)
+
+	default:
+		return fmt.Errorf("dataframe: unknown JSON orient %d", cfg.orient)
 	}
-	fmt.Printf("isMap: %v\n", isMap)
+}
 
+// eachRowJSON calls fn with the JSON representation of the values in each row.
+// The values are only valid until fn returns.
+func (df *DataFrame) eachRowJSON(cfg *toJSONConfig, fn func(values []interface{}) error) error {
 	// Iterate over the rows and extract one row at a time.
-	it := iterator.NewStepIteratorForColumns(df.Columns())
+	it, err := iterator.NewStepIteratorWithOptions(df.Columns(), iterator.WithJSONOptions(cfg.jsonOptions))
+	if err != nil {
+		return err
+	}
 	defer it.Release()
 
-	enc := json.NewEncoder(w)
-
 	for it.Next() {
 		stepValue, err := it.ValuesJSON()
 		if err != nil {
 			return err
 		}
-		// At this point everything in stepValue is json.
-		// We just have to build the object from it.
-		jsonObj := make(map[string]interface{})
-		for i, jsonValue := range stepValue.ValuesJSON {
-			if isMap[i] {
-				fmt.Printf("jsonValue: %#v\n", jsonValue)
-				obj, ok := mergeKeyValuePairs(jsonValue)
-				if !ok {
-					fmt.Println("could not merge key value pairs")
-					continue
-				}
-				jsonObj[names[i]] = obj
-				continue
-			}
-			jsonObj[names[i]] = jsonValue
-		}
-
-		err = enc.Encode(jsonObj)
-		if err != nil {
+		if err := fn(stepValue.ValuesJSON); err != nil {
 			return err
 		}
 	}
@@ -80,49 +160,72 @@ func (df *DataFrame) ToJSON(w io.Writer) error {
 	return nil
 }
 
-func mergeKeyValuePairs(keyValuePairs interface{}) (*map[string]interface{}, bool) {
-	if keyValuePairs == nil {
-		return nil, true
-	}
-	kvps, ok := interfaceToArrayInterface(keyValuePairs)
-	if !ok {
-		fmt.Println("could not convert to array iface correctly")
-		return nil, false
-	}
-	if kvps == nil {
-		return nil, true
+// writeColumnsJSON writes an object of the values for each column, in the order of the columns.
+func (df *DataFrame) writeColumnsJSON(w io.Writer, cfg *toJSONConfig) error {
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return err
 	}
 
-	obj := make(map[string]interface{})
-	for _, kvPairIface := range kvps {
-		kvPair, ok := kvPairIface.(map[string]interface{})
-		if !ok {
-			fmt.Println("could not convert to map correctly")
-			return nil, false
+	for i := range df.cols {
+		name, err := json.Marshal(df.cols[i].Name())
+		if err != nil {
+			return err
 		}
-		key, ok := kvPair["Key"].(string)
-		if !ok {
-			return nil, false
+		prefix := string(name) + ":["
+		if i > 0 {
+			prefix = "," + prefix
+		}
+
+		err = writeJSONArray(w, prefix, "]", func(write func(interface{}) error) error {
+			it := iterator.NewValueIterator(&df.cols[i])
+			defer it.Release()
+
+			for it.Next() {
+				v, err := cfg.jsonOptions.ValueAsJSON(it)
+				if err != nil {
+					return err
+				}
+				if err := write(v); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
-		value := kvPair["Value"]
-		obj[key] = value
 	}
-	return &obj, true
+
+	_, err := io.WriteString(w, "}\n")
+	return err
 }
 
-func interfaceToArrayInterface(a interface{}) ([]interface{}, bool) {
-	v := reflect.ValueOf(a)
-	if v.IsNil() {
-		return nil, true
+// writeJSONArray writes prefix, the comma separated elements written by fn and then suffix,
+// so that large arrays can be written without holding them in memory.
+func writeJSONArray(w io.Writer, prefix, suffix string, fn func(write func(interface{}) error) error) error {
+	if _, err := io.WriteString(w, prefix); err != nil {
+		return err
 	}
-	switch v.Kind() {
-	case reflect.Slice, reflect.Array:
-		result := make([]interface{}, v.Len())
-		for i := 0; i < v.Len(); i++ {
-			result[i] = v.Index(i).Interface()
+
+	first := true
+	err := fn(func(v interface{}) error {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
 		}
-		return result, true
-	default:
-		return nil, false
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = w.Write(b)
+		return err
+	})
+	if err != nil {
+		return err
 	}
+
+	_, err = io.WriteString(w, suffix)
+	return err
 }
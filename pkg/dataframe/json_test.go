@@ -17,14 +17,18 @@ package dataframe
 import (
 	"bytes"
 	"fmt"
-	"reflect"
+	"math"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/decimal128"
 	"github.com/apache/arrow/go/arrow/float16"
-	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/object"
+	"github.com/gomem/gomem/pkg/smartbuilder"
 )
 
 const (
@@ -45,35 +49,34 @@ func f16sFrom(vs []float64) []float16.Num {
 }
 
 func TestToJSON(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	schema := arrow.NewSchema(
 		[]arrow.Field{
-			{Name: "col0-i32", Type: arrow.PrimitiveTypes.Int32},
-			{Name: "col1-f64", Type: arrow.PrimitiveTypes.Float64},
-			{Name: "col2-f16", Type: arrow.FixedWidthTypes.Float16},
-			{Name: "col3-date32", Type: arrow.PrimitiveTypes.Date32},
-			{Name: "col4-date64", Type: arrow.PrimitiveTypes.Date64},
-			{Name: "col5-mitvl", Type: arrow.FixedWidthTypes.MonthInterval},
-			{Name: "col6-dtitvl", Type: arrow.FixedWidthTypes.DayTimeInterval},
-			{Name: "col7-dec128", Type: &arrow.Decimal128Type{Precision: 10, Scale: 1}},
-			{Name: "col8-duration-s", Type: arrow.FixedWidthTypes.Duration_s},
-			{Name: "col9-ts-s", Type: arrow.FixedWidthTypes.Timestamp_s},
-			{Name: "col10-bool", Type: arrow.FixedWidthTypes.Boolean},
-			{Name: "col11-string", Type: arrow.BinaryTypes.String},
-			{Name: "col12-list", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+			{Name: "col0-i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			{Name: "col1-f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "col2-f16", Type: arrow.FixedWidthTypes.Float16, Nullable: true},
+			{Name: "col3-date32", Type: arrow.PrimitiveTypes.Date32, Nullable: true},
+			{Name: "col4-date64", Type: arrow.PrimitiveTypes.Date64, Nullable: true},
+			{Name: "col5-mitvl", Type: arrow.FixedWidthTypes.MonthInterval, Nullable: true},
+			{Name: "col6-dtitvl", Type: arrow.FixedWidthTypes.DayTimeInterval, Nullable: true},
+			{Name: "col7-dec128", Type: &arrow.Decimal128Type{Precision: 10, Scale: 1}, Nullable: true},
+			{Name: "col8-duration-s", Type: arrow.FixedWidthTypes.Duration_s, Nullable: true},
+			{Name: "col9-ts-s", Type: arrow.FixedWidthTypes.Timestamp_s, Nullable: true},
+			{Name: "col10-bool", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+			{Name: "col11-string", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "col12-list", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
 			{Name: "col13-struct", Type: arrow.StructOf([]arrow.Field{
 				{Name: "field1", Type: arrow.BinaryTypes.String},
 				{Name: "field2", Type: arrow.BinaryTypes.String},
 				{Name: "field3", Type: arrow.PrimitiveTypes.Float64},
-			}...)},
-			{Name: "col14-list", Type: arrow.ListOf(arrow.ListOf(arrow.BinaryTypes.String))},
+			}...), Nullable: true},
+			{Name: "col14-list", Type: arrow.ListOf(arrow.ListOf(arrow.BinaryTypes.String)), Nullable: true},
 			{Name: "col15-los", Type: arrow.ListOf(arrow.StructOf([]arrow.Field{
 				{Name: "field_a", Type: arrow.BinaryTypes.String},
 				{Name: "field_b", Type: arrow.BinaryTypes.String},
 				{Name: "field_c", Type: arrow.PrimitiveTypes.Float64},
-			}...))},
+			}...)), Nullable: true},
 		},
 		nil,
 	)
@@ -83,7 +86,7 @@ func TestToJSON(t *testing.T) {
 
 	valids := []bool{true, true, true, false, true}
 	float16Values := f16sFrom([]float64{1, 2, 3, 4, 5})
-	dayTimeIntervalValues := []arrow.DayTimeInterval{{1, 1}, {2, 2}, {3, 3}, {4, 4}, {5, 5}}
+	dayTimeIntervalValues := []arrow.DayTimeInterval{{Days: 1, Milliseconds: 1}, {Days: 2, Milliseconds: 2}, {Days: 3, Milliseconds: 3}, {Days: 4, Milliseconds: 4}, {Days: 5, Milliseconds: 5}}
 	decimal128Values := []decimal128.Num{decimal128.New(1, 1), decimal128.New(2, 2), decimal128.New(3, 3), {}, decimal128.FromI64(-5)}
 	recordBuilder.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3, 4, 5}, valids)
 	recordBuilder.Field(1).(*array.Float64Builder).AppendValues([]float64{1, 2, 3, 4, 5}, valids)
@@ -203,36 +206,399 @@ func addListOfStructs(fi int, t *testing.T, recordBuilder *array.RecordBuilder,
 	}
 }
 
-func Test_mergeKeyValuePairs(t *testing.T) {
-	testCases := []struct {
-		keyValuePairs []map[string]interface{}
-		want          *map[string]interface{}
-	}{
-		{
-			[]map[string]interface{}{
-				{"Key": "foo", "Value": 0},
-				{"Key": "bar", "Value": 1},
-				{"Key": "ping", "Value": "pong"},
-				{"Key": "beep", "Value": "boop"},
-			},
-			&map[string]interface{}{"foo": 0, "bar": 1, "ping": "pong", "beep": "boop"},
-		},
-		{
-			[]map[string]interface{}{},
-			&map[string]interface{}{},
-		},
-		{
-			nil,
+func TestToJSONMap(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []int32{1, 2, 3},
+		"B": []map[string]interface{}{
+			{"foo": "bar", "ping": "pong"},
 			nil,
+			{"beep": "boop", "null": nil},
+		},
+		"C": []map[int]float64{
+			{2: 2.5, 1: 1.5},
+			{},
+			{3: 3.5},
 		},
+	})
+	if err != nil {
+		t.Fatal(err)
 	}
-	for _, testCase := range testCases {
-		got, ok := mergeKeyValuePairs(testCase.keyValuePairs)
-		if !ok {
-			t.Error("mergeKeyValuePairs returned not ok")
+	defer df.Release()
+
+	var b bytes.Buffer
+	if err := df.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"A":1,"B":{"foo":"bar","ping":"pong"},"C":{"1":1.5,"2":2.5}}
+{"A":2,"B":null,"C":{}}
+{"A":3,"B":{"beep":"boop","null":null},"C":{"3":3.5}}
+`
+	if got := b.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
+
+func TestToJSONUnion(t *testing.T) {
+	for _, mode := range []logical.UnionMode{logical.SparseMode, logical.DenseMode} {
+		t.Run(mode.String(), func(t *testing.T) {
+			testToJSONUnion(t, mode)
+		})
+	}
+}
+
+func testToJSONUnion(t *testing.T, mode logical.UnionMode) {
+	pool := gomemtest.NewAllocator(t)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		logical.UnionField("payload", mode, []arrow.Field{
+			{Name: "count", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "message", Type: arrow.BinaryTypes.String},
+			{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+		}, nil),
+	}, nil)
+
+	recordBuilder := array.NewRecordBuilder(pool, schema)
+	defer recordBuilder.Release()
+
+	smartBuilder := smartbuilder.NewSmartBuilder(recordBuilder)
+	for _, v := range []interface{}{int64(1), "hello", nil, []string{"a", "b"}} {
+		if err := smartBuilder.Append(0, v); err != nil {
+			t.Fatal(err)
 		}
-		if !reflect.DeepEqual(got, testCase.want) {
-			t.Fatalf("got=%v, want=%v", got, testCase.want)
+	}
+
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+
+	df, err := NewDataFrameFromRecord(pool, rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	var b bytes.Buffer
+	if err := df.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"payload":1}
+{"payload":"hello"}
+{"payload":null}
+{"payload":["a","b"]}
+`
+	if got := b.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	// Taking rows rebuilds the value offsets of dense unions.
+	ib := array.NewInt64Builder(pool)
+	defer ib.Release()
+	ib.AppendValues([]int64{3, 1, 0}, nil)
+	indices := ib.NewInt64Array()
+	defer indices.Release()
+
+	takeDf, err := df.Take(indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer takeDf.Release()
+
+	b.Reset()
+	if err := takeDf.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want = `{"payload":["a","b"]}
+{"payload":"hello"}
+{"payload":1}
+`
+	if got := b.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
+
+func TestToJSONExtensionTypes(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		logical.ExtensionField("id", logical.UUIDType{}, true),
+		logical.ExtensionField("doc", logical.JSONType{}, true),
+		logical.ExtensionField("v4", logical.IPv4Type{}, true),
+		logical.ExtensionField("v6", logical.IPv6Type{}, true),
+	}, nil)
+
+	recordBuilder := array.NewRecordBuilder(pool, schema)
+	defer recordBuilder.Release()
+
+	id := [16]byte{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+	rows := [][]interface{}{
+		{id, `{ "a": [1, 2] }`, "192.0.2.1", "2001:DB8::1"},
+		{"00000000-0000-0000-0000-00000000000A", []byte(`"text"`), [4]byte{10, 0, 0, 1}, "192.0.2.1"},
+		{nil, nil, nil, nil},
+	}
+	smartBuilder := smartbuilder.NewSmartBuilder(recordBuilder)
+	for _, row := range rows {
+		for i, v := range row {
+			if err := smartBuilder.Append(i, v); err != nil {
+				t.Fatal(err)
+			}
 		}
 	}
+	if err := smartBuilder.Append(0, "not-a-uuid"); err == nil {
+		t.Fatal("expected an error appending an invalid UUID")
+	}
+
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+
+	df, err := NewDataFrameFromRecord(pool, rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	var b bytes.Buffer
+	if err := df.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"doc":{"a":[1,2]},"id":"123e4567-e89b-12d3-a456-426614174000","v4":"192.0.2.1","v6":"2001:db8::1"}
+{"doc":"text","id":"00000000-0000-0000-0000-00000000000a","v4":"10.0.0.1","v6":"::ffff:192.0.2.1"}
+{"doc":null,"id":null,"v4":null,"v6":null}
+`
+	if got := b.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
+
+func TestToJSONExtensionInvalidValue(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		logical.ExtensionField("doc", logical.JSONType{}, true),
+	}, nil)
+
+	recordBuilder := array.NewRecordBuilder(pool, schema)
+	defer recordBuilder.Release()
+
+	// The storage is written directly so it can hold a value that is not JSON.
+	recordBuilder.Field(0).(*array.StringBuilder).Append("not json")
+
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+
+	df, err := NewDataFrameFromRecord(pool, rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	var b bytes.Buffer
+	if err := df.ToJSON(&b); err == nil {
+		t.Fatal("expected an error writing a value that is not JSON")
+	}
+}
+
+// int64ExtensionType is an extension type over a storage type that extensions can't use.
+type int64ExtensionType struct{}
+
+func (int64ExtensionType) ExtensionName() string              { return "test.int64" }
+func (int64ExtensionType) StorageType() arrow.DataType        { return arrow.PrimitiveTypes.Int64 }
+func (int64ExtensionType) AsJSON(o object.Object) interface{} { return o }
+func (int64ExtensionType) ToStorage(o object.Object) interface{} {
+	return int64(o.(object.Int64))
+}
+func (int64ExtensionType) CastObject(v interface{}) (object.Object, error) {
+	o, ok := object.CastToInt64(v)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast %v (%T) to an Int64", v, v)
+	}
+	return o, nil
+}
+
+func TestExtensionTypeUnsupportedStorage(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	if err := logical.RegisterExtensionType(int64ExtensionType{}); err == nil {
+		t.Fatal("expected an error registering an extension type stored as int64")
+	}
+
+	// The field is only marked as an extension so the values are read as int64.
+	schema := arrow.NewSchema([]arrow.Field{logical.ExtensionField("n", int64ExtensionType{}, false)}, nil)
+	recordBuilder := array.NewRecordBuilder(pool, schema)
+	defer recordBuilder.Release()
+	recordBuilder.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+
+	df, err := NewDataFrameFromRecord(pool, rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	var b bytes.Buffer
+	if err := df.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), "{\"n\":1}\n{\"n\":2}\n"; got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
+
+func TestToJSONWithOptions(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []int64{9007199254740993, 2},
+		"B": []float64{math.NaN(), 1.5},
+		"C": []map[int]float64{{1: 1.5}, {}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	var b bytes.Buffer
+	opts := &iterator.JSONOptions{
+		Int64AsString: true,
+		NonFinite:     iterator.NonFiniteAsNull,
+		MapFormat:     iterator.MapAsEntries,
+	}
+	if err := df.ToJSON(&b, WithJSONOptions(opts)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"A":"9007199254740993","B":null,"C":[{"key":"1","value":1.5}]}
+{"A":"2","B":1.5,"C":[]}
+`
+	if got := b.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	if err := df.ToJSON(&b, WithLsuffix("_l")); err == nil {
+		t.Fatal("expected an error applying WithLsuffix to ToJSON")
+	}
+}
+
+func TestToJSONOrient(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"a": []int32{1, 2},
+		"b": []string{"x", "y"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	cases := []struct {
+		orient Orient
+		want   string
+	}{
+		{OrientRecordLines, "{\"a\":1,\"b\":\"x\"}\n{\"a\":2,\"b\":\"y\"}\n"},
+		{OrientRecords, "[{\"a\":1,\"b\":\"x\"},{\"a\":2,\"b\":\"y\"}]\n"},
+		{OrientColumns, "{\"a\":[1,2],\"b\":[\"x\",\"y\"]}\n"},
+		{OrientSplit, "{\"columns\":[\"a\",\"b\"],\"data\":[[1,\"x\"],[2,\"y\"]]}\n"},
+		{OrientValues, "[[1,\"x\"],[2,\"y\"]]\n"},
+	}
+	for _, c := range cases {
+		var b bytes.Buffer
+		if err := df.ToJSON(&b, WithOrient(c.orient)); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != c.want {
+			t.Errorf("orient %d:\ngot=\n%v\nwant=\n%v", c.orient, got, c.want)
+		}
+	}
+
+	empty, err := df.Slice(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer empty.Release()
+	var b bytes.Buffer
+	if err := empty.ToJSON(&b, WithOrient(OrientRecords)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), "[]\n"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	if err := df.ToJSON(&b, WithOrient(Orient(-1))); err == nil {
+		t.Fatal("expected an error for an unknown orient")
+	}
+}
+
+func TestToArrowJSON(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String},
+			{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+			{Name: "struct", Type: arrow.StructOf(arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Float64})},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 0, 9007199254740993}, []bool{true, false, true})
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "bc", ""}, nil)
+	lb := b.Field(2).(*array.ListBuilder)
+	vb := lb.ValueBuilder().(*array.Int32Builder)
+	lb.Append(true)
+	vb.AppendValues([]int32{1, 2}, nil)
+	lb.AppendNull()
+	lb.Append(true)
+	vb.Append(3)
+	sb := b.Field(3).(*array.StructBuilder)
+	fb := sb.FieldBuilder(0).(*array.Float64Builder)
+	for _, v := range []float64{0.5, 1.5, 2.5} {
+		sb.Append(true)
+		fb.Append(v)
+	}
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	df, err := NewDataFrameFromRecord(pool, rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	// Skip the first row so that the offsets have to be made relative.
+	sliced, err := df.Slice(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sliced.Release()
+
+	var out bytes.Buffer
+	if err := sliced.ToArrowJSON(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"schema":{"fields":[` +
+		`{"name":"i64","type":{"bitWidth":64,"isSigned":true,"name":"int"},"nullable":true,"children":[]},` +
+		`{"name":"str","type":{"name":"utf8"},"nullable":false,"children":[]},` +
+		`{"name":"list","type":{"name":"list"},"nullable":true,"children":[{"name":"item","type":{"bitWidth":32,"isSigned":true,"name":"int"},"nullable":true,"children":[]}]},` +
+		`{"name":"struct","type":{"name":"struct"},"nullable":false,"children":[{"name":"f","type":{"name":"floatingpoint","precision":"DOUBLE"},"nullable":false,"children":[]}]}]},` +
+		`"batches":[{"count":2,"columns":[` +
+		`{"name":"i64","count":2,"VALIDITY":[0,1],"DATA":["0","9007199254740993"]},` +
+		`{"name":"str","count":2,"VALIDITY":[1,1],"OFFSET":[0,2,2],"DATA":["bc",""]},` +
+		`{"name":"list","count":2,"VALIDITY":[0,1],"OFFSET":[0,0,1],"children":[{"name":"item","count":1,"VALIDITY":[1],"DATA":[3]}]},` +
+		`{"name":"struct","count":2,"VALIDITY":[1,1],"children":[{"name":"f","count":2,"VALIDITY":[1,1],"DATA":[1.5,2.5]}]}]}]}
+`
+	if got := out.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
 }
@@ -0,0 +1,186 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// ErrMemoryLimit is the error returned by mutations when an allocation
+// would exceed the budget of a LimitedAllocator.
+type ErrMemoryLimit struct {
+	// Limit is the budget of the allocator in bytes.
+	Limit int
+	// Allocated is the number of bytes that were allocated when the allocation was attempted.
+	Allocated int
+	// Requested is the number of additional bytes the allocation needed.
+	Requested int
+}
+
+func (e *ErrMemoryLimit) Error() string {
+	return fmt.Sprintf("dataframe: allocating %d bytes would exceed the memory limit of %d bytes (%d allocated)", e.Requested, e.Limit, e.Allocated)
+}
+
+// LimitedAllocator is a memory.Allocator that allows at most a fixed number of bytes
+// to be allocated at once. It is safe for concurrent use.
+//
+// memory.Allocator has no way to return an error so an allocation that would exceed the limit
+// panics with an *ErrMemoryLimit. Mutators recover the panic and return it as their error.
+type LimitedAllocator struct {
+	mem   memory.Allocator
+	limit int
+
+	mu        sync.Mutex
+	allocated int
+}
+
+// NewLimitedAllocator creates a new LimitedAllocator that allocates at most limit bytes from mem.
+func NewLimitedAllocator(mem memory.Allocator, limit int) *LimitedAllocator {
+	return &LimitedAllocator{
+		mem:   mem,
+		limit: limit,
+	}
+}
+
+// Allocate allocates size bytes.
+// It panics with an *ErrMemoryLimit when the allocation would exceed the limit.
+func (a *LimitedAllocator) Allocate(size int) []byte {
+	a.reserve(size)
+	return a.mem.Allocate(size)
+}
+
+// Reallocate resizes b to size bytes.
+// It panics with an *ErrMemoryLimit when the allocation would exceed the limit.
+func (a *LimitedAllocator) Reallocate(size int, b []byte) []byte {
+	a.reserve(size - len(b))
+	return a.mem.Reallocate(size, b)
+}
+
+// Free releases b.
+func (a *LimitedAllocator) Free(b []byte) {
+	a.mu.Lock()
+	a.allocated -= len(b)
+	a.mu.Unlock()
+	a.mem.Free(b)
+}
+
+// Allocated returns the number of bytes that are currently allocated.
+func (a *LimitedAllocator) Allocated() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.allocated
+}
+
+// Limit returns the maximum number of bytes that may be allocated at once.
+func (a *LimitedAllocator) Limit() int { return a.limit }
+
+func (a *LimitedAllocator) reserve(size int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if size > 0 && a.allocated+size > a.limit {
+		panic(&ErrMemoryLimit{Limit: a.limit, Allocated: a.allocated, Requested: size})
+	}
+	a.allocated += size
+}
+
+// recoverMemoryLimit turns a panic with an *ErrMemoryLimit into err.
+// It must be deferred directly. Any other panic continues.
+func recoverMemoryLimit(err *error) {
+	if r := recover(); r != nil {
+		limitErr, ok := r.(*ErrMemoryLimit)
+		if !ok {
+			panic(r)
+		}
+		*err = limitErr
+	}
+}
+
+// limitMemory returns a MutationFunc that calls fn and returns an *ErrMemoryLimit
+// as its error when fn exceeds the budget of a LimitedAllocator.
+func limitMemory(fn MutationFunc) MutationFunc {
+	return func(df *DataFrame) (_ *DataFrame, err error) {
+		defer recoverMemoryLimit(&err)
+		return fn(df)
+	}
+}
+
+// MemoryUsage returns the number of bytes held by the buffers of each column, keyed by column name.
+// A buffer is counted at its full capacity, even when the column only uses a slice of it.
+// Buffers shared between columns are only counted for the first column that holds them.
+func (df *DataFrame) MemoryUsage() map[string]int64 {
+	seen := make(map[*memory.Buffer]struct{})
+	usage := make(map[string]int64, len(df.cols))
+	for i := range df.cols {
+		usage[df.cols[i].Name()] += columnMemoryUsage(&df.cols[i], seen)
+	}
+	return usage
+}
+
+// TotalMemoryUsage returns the number of bytes held by the buffers of the DataFrames.
+// Buffers shared between the DataFrames, such as between a DataFrame and the DataFrames
+// created from it by Copy or Slice, are only counted once.
+func TotalMemoryUsage(dfs ...*DataFrame) int64 {
+	seen := make(map[*memory.Buffer]struct{})
+	var total int64
+	for _, df := range dfs {
+		for i := range df.cols {
+			total += columnMemoryUsage(&df.cols[i], seen)
+		}
+	}
+	return total
+}
+
+func columnMemoryUsage(col *array.Column, seen map[*memory.Buffer]struct{}) int64 {
+	var total int64
+	for _, chunk := range col.Data().Chunks() {
+		total += arrayMemoryUsage(chunk, seen)
+	}
+	return total
+}
+
+func arrayMemoryUsage(arr array.Interface, seen map[*memory.Buffer]struct{}) int64 {
+	var total int64
+	for _, buf := range arr.Data().Buffers() {
+		if buf == nil {
+			continue
+		}
+		if _, ok := seen[buf]; ok {
+			continue
+		}
+		seen[buf] = struct{}{}
+		total += int64(buf.Cap())
+	}
+
+	// array.Data doesn't expose its children so we reach them through the nested arrays.
+	switch a := arr.(type) {
+	case *array.List:
+		total += arrayMemoryUsage(a.ListValues(), seen)
+	case *array.FixedSizeList:
+		total += arrayMemoryUsage(a.ListValues(), seen)
+	case *array.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			total += arrayMemoryUsage(a.Field(i), seen)
+		}
+	}
+	return total
+}
+
+var (
+	_ memory.Allocator = (*LimitedAllocator)(nil)
+)
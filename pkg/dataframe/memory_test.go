@@ -0,0 +1,120 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gomem/gomem/pkg/gomemtest"
+)
+
+func TestLimitedAllocator(t *testing.T) {
+	pool := NewLimitedAllocator(gomemtest.NewAllocator(t), 4096)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"a": []int32{1, 2, 3, 4, 5, 6, 7, 8},
+		"b": []string{"a", "b", "c", "d", "e", "f", "g", "h"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	if got := pool.Allocated(); got <= 0 || got > pool.Limit() {
+		t.Fatalf("got=%d, want between 1 and %d", got, pool.Limit())
+	}
+
+	// The cross join needs many more rows than the budget allows.
+	big, err := df.CrossJoin(df)
+	for i := 0; err == nil && i < 4; i++ {
+		next, joinErr := big.CrossJoin(df)
+		big.Release()
+		big, err = next, joinErr
+	}
+	if err == nil {
+		big.Release()
+		t.Fatal("expected the cross join to exceed the memory limit")
+	}
+
+	var limitErr *ErrMemoryLimit
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("got=%T (%v), want=*ErrMemoryLimit", err, err)
+	}
+	if got, want := limitErr.Limit, 4096; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+
+	// Apply reports the limit from any MutationFunc.
+	_, err = df.Apply(func(df *DataFrame) (*DataFrame, error) {
+		pool.Allocate(pool.Limit())
+		return df, nil
+	})
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("got=%T (%v), want=*ErrMemoryLimit", err, err)
+	}
+}
+
+func TestMemoryUsage(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"a": []int32{1, 2, 3, 4},
+		"b": []string{"a", "b", "c", "d"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	usage := df.MemoryUsage()
+	if got, want := len(usage), 2; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	var sum int64
+	for name, bytes := range usage {
+		if bytes <= 0 {
+			t.Fatalf("column %q: got=%d, want > 0", name, bytes)
+		}
+		sum += bytes
+	}
+
+	total := TotalMemoryUsage(df)
+	if got, want := sum, total; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if got, want := total, int64(pool.CurrentAlloc()); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+
+	// Copies and slices share their buffers with df so they are only counted once.
+	cp, err := df.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Release()
+	sliced, err := df.Slice(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sliced.Release()
+
+	if got, want := TotalMemoryUsage(df, cp, sliced), total; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if got, want := TotalMemoryUsage(sliced), total; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/gomem/gomem/pkg/metadata"
+)
+
+// Metadata returns the key-value metadata of the DataFrame schema.
+func (df *DataFrame) Metadata() arrow.Metadata {
+	return df.schema.Metadata()
+}
+
+// SetMetadata returns a DataFrame with key set to value in the schema metadata.
+func (df *DataFrame) SetMetadata(key, value string) (*DataFrame, error) {
+	return df.withMetadata(metadata.Set(df.Metadata(), key, value))
+}
+
+// MergeMetadata returns a DataFrame with md merged into the schema metadata.
+// Keys that already exist have their values replaced by the ones in md.
+func (df *DataFrame) MergeMetadata(md arrow.Metadata) (*DataFrame, error) {
+	return df.withMetadata(metadata.Merge(df.Metadata(), md))
+}
+
+// ColumnMetadata returns the key-value metadata of the named column's field.
+// It is empty when there is no such column.
+func (df *DataFrame) ColumnMetadata(name string) arrow.Metadata {
+	col := df.Column(name)
+	if col == nil {
+		return arrow.Metadata{}
+	}
+	return col.Field().Metadata
+}
+
+// SetColumnMetadata returns a DataFrame with key set to value in the metadata of the named column's field.
+func (df *DataFrame) SetColumnMetadata(name, key, value string) (*DataFrame, error) {
+	return df.withColumnMetadata(name, func(md arrow.Metadata) arrow.Metadata {
+		return metadata.Set(md, key, value)
+	})
+}
+
+// MergeColumnMetadata returns a DataFrame with md merged into the metadata of the named column's field.
+// Keys that already exist have their values replaced by the ones in md.
+func (df *DataFrame) MergeColumnMetadata(name string, md arrow.Metadata) (*DataFrame, error) {
+	return df.withColumnMetadata(name, func(existing arrow.Metadata) arrow.Metadata {
+		return metadata.Merge(existing, md)
+	})
+}
+
+// withMetadata returns a DataFrame with the same columns and md as its schema metadata.
+func (df *DataFrame) withMetadata(md arrow.Metadata) (*DataFrame, error) {
+	out, err := df.Copy()
+	if err != nil {
+		return nil, err
+	}
+	out.setMetadata(md)
+	return out, nil
+}
+
+// withColumnMetadata returns a DataFrame with fn applied to the metadata of the named column's field.
+func (df *DataFrame) withColumnMetadata(name string, fn func(arrow.Metadata) arrow.Metadata) (*DataFrame, error) {
+	for i := range df.cols {
+		if df.cols[i].Name() != name {
+			continue
+		}
+		field := df.cols[i].Field()
+		field.Metadata = fn(field.Metadata)
+		return df.replaceColumnFromChunks(i, field, df.cols[i].Data().Chunks())
+	}
+	return nil, fmt.Errorf("dataframe: column %s is not in DataFrame: (%v)", name, df.ColumnNames())
+}
+
+// setMetadata replaces the schema metadata of a DataFrame that hasn't been returned to the caller yet.
+func (df *DataFrame) setMetadata(md arrow.Metadata) {
+	df.schema = arrow.NewSchema(df.schema.Fields(), &md)
+}
+
+// inheritMetadata gives out, a DataFrame built from the columns of df, the schema metadata of df.
+func (df *DataFrame) inheritMetadata(out *DataFrame, err error) (*DataFrame, error) {
+	if err != nil {
+		return nil, err
+	}
+	if df.schema.HasMetadata() {
+		out.setMetadata(df.Metadata())
+	}
+	return out, nil
+}
+
+// keepMetadata returns a MutationFunc that calls fn and gives the resulting DataFrame the schema metadata
+// of the DataFrame it was called with, unless fn already set schema metadata on it.
+func keepMetadata(fn MutationFunc) MutationFunc {
+	return func(df *DataFrame) (*DataFrame, error) {
+		out, err := fn(df)
+		if err != nil || out == nil || out == df {
+			return out, err
+		}
+		if !out.schema.HasMetadata() && df.schema.HasMetadata() {
+			out.setMetadata(df.Metadata())
+		}
+		return out, nil
+	}
+}
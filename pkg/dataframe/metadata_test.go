@@ -0,0 +1,198 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/metadata"
+)
+
+func newMetadataDataFrame(t *testing.T, pool *gomemtest.CheckedAllocator) *DataFrame {
+	t.Helper()
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []int32{1, 2, 3},
+		"B": []float64{1.5, 2.5, 3.5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	withSource, err := df.SetMetadata("source", "sensors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer withSource.Release()
+
+	withUnits, err := withSource.SetColumnMetadata("B", "unit", "celsius")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return withUnits
+}
+
+func assertMetadata(t *testing.T, md arrow.Metadata, key, want string) {
+	t.Helper()
+	got, ok := metadata.Value(md, key)
+	if !ok || got != want {
+		t.Fatalf("%s: got=%q (%v), want=%q", key, got, ok, want)
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df := newMetadataDataFrame(t, pool)
+	defer df.Release()
+
+	assertMetadata(t, df.Metadata(), "source", "sensors")
+	assertMetadata(t, df.ColumnMetadata("B"), "unit", "celsius")
+	if got := df.ColumnMetadata("A").Len(); got != 0 {
+		t.Fatalf("got=%d keys, want none", got)
+	}
+
+	merged, err := df.MergeMetadata(arrow.MetadataFrom(map[string]string{"source": "lab", "run": "7"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer merged.Release()
+	assertMetadata(t, merged.Metadata(), "source", "lab")
+	assertMetadata(t, merged.Metadata(), "run", "7")
+	// The DataFrame it was merged from doesn't change.
+	assertMetadata(t, df.Metadata(), "source", "sensors")
+
+	mergedCol, err := merged.MergeColumnMetadata("B", arrow.MetadataFrom(map[string]string{"precision": "0.1"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mergedCol.Release()
+	assertMetadata(t, mergedCol.ColumnMetadata("B"), "unit", "celsius")
+	assertMetadata(t, mergedCol.ColumnMetadata("B"), "precision", "0.1")
+	assertMetadata(t, mergedCol.Metadata(), "run", "7")
+
+	if _, err := df.SetColumnMetadata("Z", "unit", "m"); err == nil {
+		t.Fatal("expected an error for a missing column")
+	}
+}
+
+func TestMetadataSurvivesMutations(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df := newMetadataDataFrame(t, pool)
+	defer df.Release()
+
+	selected, err := df.Select("B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer selected.Release()
+	assertMetadata(t, selected.Metadata(), "source", "sensors")
+	assertMetadata(t, selected.ColumnMetadata("B"), "unit", "celsius")
+
+	sliced, err := df.Slice(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sliced.Release()
+	assertMetadata(t, sliced.Metadata(), "source", "sensors")
+	assertMetadata(t, sliced.ColumnMetadata("B"), "unit", "celsius")
+
+	right, err := NewDataFrameFromMem(pool, Dict{
+		"A": []int32{1, 3},
+		"C": []string{"x", "y"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer right.Release()
+	right2, err := right.MergeMetadata(arrow.MetadataFrom(map[string]string{"source": "labels", "owner": "ops"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer right2.Release()
+
+	joined, err := df.InnerJoin(right2, []string{"A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer joined.Release()
+	// The left DataFrame wins when both have the same key.
+	assertMetadata(t, joined.Metadata(), "source", "sensors")
+	assertMetadata(t, joined.Metadata(), "owner", "ops")
+	assertMetadata(t, joined.ColumnMetadata("B"), "unit", "celsius")
+
+	concat, err := sliced.Concat(df)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer concat.Release()
+	got := concat.Display(-1)
+	want := `rec[0]["A"]: [2]
+rec[0]["B"]: [2.5]
+rec[1]["A"]: [1 2 3]
+rec[1]["B"]: [1.5 2.5 3.5]
+`
+	if got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+	assertMetadata(t, concat.Metadata(), "source", "sensors")
+	assertMetadata(t, concat.ColumnMetadata("B"), "unit", "celsius")
+
+	if _, err := df.Concat(right); err == nil {
+		t.Fatal("expected an error concatenating DataFrames with different columns")
+	}
+}
+
+func TestMetadataSerialization(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df := newMetadataDataFrame(t, pool)
+	defer df.Release()
+
+	var b bytes.Buffer
+	if err := df.ToArrowJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"metadata":[{"key":"unit","value":"celsius"}]`,
+		`"metadata":[{"key":"source","value":"sensors"}]`,
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Fatalf("expected %s in\n%s", want, b.String())
+		}
+	}
+
+	b.Reset()
+	if err := df.ToIPC(&b); err != nil {
+		t.Fatal(err)
+	}
+	read, err := NewDataFrameFromIPC(pool, &b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer read.Release()
+
+	if !read.Equals(df) {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", read.Display(-1), df.Display(-1))
+	}
+	assertMetadata(t, read.Metadata(), "source", "sensors")
+	assertMetadata(t, read.ColumnMetadata("B"), "unit", "celsius")
+}
@@ -21,10 +21,13 @@ import (
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/metadata"
 	"github.com/gomem/gomem/pkg/smartbuilder"
 )
 
 // Mutator is a type that has some standard mutations.
+// Mutations return an *ErrMemoryLimit when they exceed the budget of a LimitedAllocator.
+// The DataFrames they return keep the schema metadata of the DataFrame they were called with.
 type Mutator struct {
 	// Almost all mutations will require setting up new memory as they create new a DataFrame.
 	// So we need to provide the ability to set the Allocator.
@@ -41,25 +44,30 @@ func NewMutator(mem memory.Allocator) *Mutator {
 // MutationFunc is a function that mutates an existing DataFrame and returns a new DataFrame or an error.
 type MutationFunc func(*DataFrame) (*DataFrame, error)
 
+// mutation wraps fn with what every Mutator method does: it limits memory and keeps the schema metadata.
+func mutation(fn MutationFunc) MutationFunc {
+	return keepMetadata(limitMemory(fn))
+}
+
 // Select the given DataFrame columns by name.
 func (m *Mutator) Select(names ...string) MutationFunc {
-	return func(df *DataFrame) (*DataFrame, error) {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
 		cols := df.SelectColumns(names...)
 		return NewDataFrameFromShape(m.mem, cols, df.NumRows())
-	}
+	})
 }
 
 // Drop the given DataFrame columns by name.
 func (m *Mutator) Drop(names ...string) MutationFunc {
-	return func(df *DataFrame) (*DataFrame, error) {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
 		cols := df.RejectColumns(names...)
 		return NewDataFrameFromShape(m.mem, cols, df.NumRows())
-	}
+	})
 }
 
 // Slice creates a new DataFrame consisting of rows[beg:end].
 func (m *Mutator) Slice(beg, end int64) MutationFunc {
-	return func(df *DataFrame) (*DataFrame, error) {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
 		if end > df.NumRows() || beg > end {
 			return nil, fmt.Errorf("mutation: index out of range")
 		}
@@ -79,13 +87,87 @@ func (m *Mutator) Slice(beg, end int64) MutationFunc {
 
 		rows := end - beg
 		return NewDataFrameFromShape(m.mem, cols, rows)
-	}
+	})
+}
+
+// Concat creates a new DataFrame with the rows of the DataFrame followed by the rows of each of others.
+// All the DataFrames must have columns with the same names and types in the same order.
+// The columns keep the fields of the first DataFrame and the schema metadata of all of them is merged,
+// with earlier DataFrames winning when they have the same key.
+func (m *Mutator) Concat(others ...*DataFrame) MutationFunc {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		rows := df.NumRows()
+		for _, other := range others {
+			if got, want := other.ColumnNames(), df.ColumnNames(); len(got) != len(want) {
+				return nil, fmt.Errorf("bullseye/concat: columns (%v) do not match (%v)", got, want)
+			}
+			for i := range df.cols {
+				if !sameFieldType(other.cols[i].Field(), df.cols[i].Field()) || other.cols[i].Name() != df.cols[i].Name() {
+					return nil, fmt.Errorf("bullseye/concat: column %s (%s) does not match column %s (%s)",
+						other.cols[i].Name(), other.cols[i].DataType(), df.cols[i].Name(), df.cols[i].DataType())
+				}
+			}
+			rows += other.NumRows()
+		}
+
+		cols := make([]array.Column, 0, len(df.cols))
+		defer func() {
+			for i := range cols {
+				cols[i].Release()
+			}
+		}()
+		for i := range df.cols {
+			var chunks []array.Interface
+			slices := make([]*array.Column, 0, len(others)+1)
+			for _, frame := range append([]*DataFrame{df}, others...) {
+				// Columns may be longer than the DataFrame so only take its rows.
+				sliced := frame.cols[i].NewSlice(0, frame.NumRows())
+				slices = append(slices, sliced)
+				chunks = append(chunks, sliced.Data().Chunks()...)
+			}
+			// NewChunked retains the chunks so the slices can be released as soon as it is built.
+			chunked := array.NewChunked(df.cols[i].DataType(), chunks)
+			for _, sliced := range slices {
+				sliced.Release()
+			}
+			cols = append(cols, *array.NewColumn(df.cols[i].Field(), chunked))
+			chunked.Release()
+		}
+
+		out, err := NewDataFrameFromShape(m.mem, cols, rows)
+		if err != nil {
+			return nil, err
+		}
+		mds := make([]arrow.Metadata, 0, len(others)+1)
+		for i := len(others) - 1; i >= 0; i-- {
+			mds = append(mds, others[i].Metadata())
+		}
+		out.setMetadata(metadata.Merge(arrow.Metadata{}, append(mds, df.Metadata())...))
+		return out, nil
+	})
 }
 
 // leftJoinConfig are the config params for LeftJoin.
 type leftJoinConfig struct {
 	lsuffix string
 	rsuffix string
+
+	// leftOn and rightOn are the key columns of each side when they have different names.
+	leftOn  []string
+	rightOn []string
+	// keyAliases renames the key columns of the result,
+	// i.e. after the left columns when RightJoin swaps the sides.
+	keyAliases []string
+	// outer makes the left columns nullable too, i.e. for OuterJoin which keeps the right rows without a match.
+	outer bool
+
+	// nullSafe makes nil keys equal to each other, like SQL IS NOT DISTINCT FROM.
+	nullSafe bool
+
+	// asOfDirection and asOfTolerance configure which right rows an AsOfJoin matches.
+	asOfDirection    AsOfDirection
+	asOfTolerance    int64
+	hasAsOfTolerance bool
 }
 
 // newLeftJoinConfig creates a new config using options and validates it.
@@ -104,9 +186,24 @@ func (c *leftJoinConfig) validate() error {
 	if c.lsuffix == c.rsuffix {
 		return fmt.Errorf("lsuffix (%s) cannot be the same as rsuffix (%s)", c.lsuffix, c.rsuffix)
 	}
+	if len(c.leftOn) != len(c.rightOn) {
+		return fmt.Errorf("leftOn (%v) must have the same number of columns as rightOn (%v)", c.leftOn, c.rightOn)
+	}
 	return nil
 }
 
+// keyNames returns the names of the key columns for each side of the join.
+// columnNames is used for both sides unless WithLeftOn and WithRightOn were given.
+func (c *leftJoinConfig) keyNames(columnNames []string) ([]string, []string, error) {
+	if len(c.leftOn) == 0 {
+		return columnNames, columnNames, nil
+	}
+	if len(columnNames) > 0 {
+		return nil, nil, fmt.Errorf("columns (%v) cannot be used together with leftOn (%v) and rightOn (%v)", columnNames, c.leftOn, c.rightOn)
+	}
+	return c.leftOn, c.rightOn, nil
+}
+
 // defaultLeftJoinConfig returns the default defaultLeftJoinConfig.
 func defaultLeftJoinConfig() *leftJoinConfig {
 	return &leftJoinConfig{
@@ -139,6 +236,46 @@ func WithRsuffix(rsuffix string) Option {
 	}
 }
 
+// WithLeftOn configures a join to use the named columns of the left DataFrame as keys.
+// It must be used together with WithRightOn and in place of the join's column names.
+// The key columns of the result are named after the left columns, including for RightJoin.
+func WithLeftOn(names ...string) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*leftJoinConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithLeftOn to: %T", p)
+		}
+		o.leftOn = names
+		return nil
+	}
+}
+
+// WithRightOn configures a join to use the named columns of the right DataFrame as keys,
+// matching them in order with the columns given to WithLeftOn.
+func WithRightOn(names ...string) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*leftJoinConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithRightOn to: %T", p)
+		}
+		o.rightOn = names
+		return nil
+	}
+}
+
+// WithNullSafeEquality configures a join to treat nil keys as equal to each other,
+// like SQL IS NOT DISTINCT FROM, instead of never matching.
+func WithNullSafeEquality() Option {
+	return func(p interface{}) error {
+		o, ok := p.(*leftJoinConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithNullSafeEquality to: %T", p)
+		}
+		o.nullSafe = true
+		return nil
+	}
+}
+
 // RightJoin returns a DataFrame containing the right join of two DataFrames.
 // Acts like SQL in that nil elements are treated as unknown so nil != nil.
 func (m *Mutator) RightJoin(rightDf *DataFrame, columnNames []string, opts ...Option) MutationFunc {
@@ -149,9 +286,12 @@ func (m *Mutator) RightJoin(rightDf *DataFrame, columnNames []string, opts ...Op
 		lsuffix := cfg.lsuffix
 		cfg.lsuffix = cfg.rsuffix
 		cfg.rsuffix = lsuffix
+		// and the key columns of each side, keeping the keys named after the left columns.
+		cfg.keyAliases = cfg.leftOn
+		cfg.leftOn, cfg.rightOn = cfg.rightOn, cfg.leftOn
 	}
 
-	return func(leftDf *DataFrame) (*DataFrame, error) {
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
 		if err != nil {
 			return nil, err
 		}
@@ -164,14 +304,14 @@ func (m *Mutator) RightJoin(rightDf *DataFrame, columnNames []string, opts ...Op
 		defer data.Release()
 		// return fn(rightDf)
 		return data.buildDataFrame()
-	}
+	})
 }
 
 // LeftJoin returns a DataFrame containing the left join of two DataFrames.
 // Acts like SQL in that nil elements are treated as unknown so nil != nil.
 func (m *Mutator) LeftJoin(rightDf *DataFrame, columnNames []string, opts ...Option) MutationFunc {
 	cfg, err := newLeftJoinConfig(opts...)
-	return func(leftDf *DataFrame) (*DataFrame, error) {
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
 		if err != nil {
 			return nil, err
 		}
@@ -182,7 +322,7 @@ func (m *Mutator) LeftJoin(rightDf *DataFrame, columnNames []string, opts ...Opt
 		}
 		defer data.Release()
 		return data.buildDataFrame()
-	}
+	})
 }
 
 type joinFuncConfig struct {
@@ -194,44 +334,86 @@ type joinFuncConfig struct {
 	additionalLeftColsLen  int
 	additionalRightColsLen int
 	columnNames            []string
+	nullSafe               bool
 	leftColumns            []array.Column
 	rightColumns           []array.Column
 	schema                 *arrow.Schema
 	recordBuilder          *array.RecordBuilder
 	smartBuilder           *smartbuilder.SmartBuilder
+
+	// Columns created for the join that must be released with it,
+	// i.e. the indices of key columns sharing a dictionary.
+	ownedColumns []*array.Column
 }
 
 // newJoinFuncConfig builds up all the data needed to do a join.
 // TODO(nickpoorman): maybe rename leftJoinConfig if this is going to be used for other joins
 func (m *Mutator) newJoinFuncConfig(cfg *leftJoinConfig, leftDf *DataFrame, rightDf *DataFrame, columnNames []string, forceNullable bool) (*joinFuncConfig, error) {
+	leftNames, rightNames, err := cfg.keyNames(columnNames)
+	if err != nil {
+		return nil, err
+	}
+
 	jc := &joinFuncConfig{
 		mutator:      m,
-		columnNames:  columnNames,
+		columnNames:  leftNames,
+		nullSafe:     cfg.nullSafe,
 		leftColumns:  make([]array.Column, 0, leftDf.NumCols()),
 		rightColumns: make([]array.Column, 0, rightDf.NumCols()),
 	}
 
 	// Start by making sure that both DataFrames have the columns we are looking for.
-	for _, name := range columnNames {
-		leftColumn := leftDf.Column(name)
+	for i := range leftNames {
+		leftColumn := leftDf.Column(leftNames[i])
 		if leftColumn == nil {
-			return nil, fmt.Errorf("bullseye/mutations: column %s is not in left DataFrame: (%v)", name, leftDf.ColumnNames())
+			return nil, fmt.Errorf("bullseye/mutations: column %s is not in left DataFrame: (%v)", leftNames[i], leftDf.ColumnNames())
 		}
-		rightColumn := rightDf.Column(name)
+		rightColumn := rightDf.Column(rightNames[i])
 		if rightColumn == nil {
-			return nil, fmt.Errorf("bullseye/mutations: column %s is not in right DataFrame: (%v)", name, rightDf.ColumnNames())
+			return nil, fmt.Errorf("bullseye/mutations: column %s is not in right DataFrame: (%v)", rightNames[i], rightDf.ColumnNames())
 		}
 
 		jc.leftColumns = append(jc.leftColumns, *leftColumn)
 		jc.rightColumns = append(jc.rightColumns, *rightColumn)
 	}
+	// Key columns sharing a dictionary are compared on their indices.
+	// Otherwise the values are decoded and the result uses a merged dictionary.
+	keyFields := make([]arrow.Field, len(leftNames))
+	for i := range leftNames {
+		leftField := jc.leftColumns[i].Field()
+		rightField := jc.rightColumns[i].Field()
+		keyFields[i] = leftField
+		switch {
+		case metadata.SameDictionary(leftField.Metadata, rightField.Metadata):
+			leftIndices := dictionaryIndices(&jc.leftColumns[i])
+			rightIndices := dictionaryIndices(&jc.rightColumns[i])
+			jc.ownedColumns = append(jc.ownedColumns, leftIndices, rightIndices)
+			jc.leftColumns[i] = *leftIndices
+			jc.rightColumns[i] = *rightIndices
+		case metadata.DictionaryTypeMetadataExists(leftField.Metadata) || metadata.DictionaryTypeMetadataExists(rightField.Metadata):
+			field, err := mergeDictionaries(leftField, rightField)
+			if err != nil {
+				jc.Release()
+				return nil, err
+			}
+			keyFields[i] = field
+		}
+		if len(cfg.keyAliases) > 0 {
+			keyFields[i].Name = cfg.keyAliases[i]
+		}
+		if cfg.outer {
+			// The keys of the right rows without a match come from the right column.
+			keyFields[i].Nullable = leftField.Nullable || rightField.Nullable
+		}
+	}
+
 	// Keep track of the number of matching left and right columns. (They should be the same number)
 	jc.matchingLeftColsLen = len(jc.leftColumns)
 	jc.matchingRightColsLen = len(jc.rightColumns)
 
 	// We will end up needing to iterate over the columns for left in step so join them back together.
-	jc.leftColumns = append(jc.leftColumns, leftDf.RejectColumns(columnNames...)...)
-	jc.rightColumns = append(jc.rightColumns, rightDf.RejectColumns(columnNames...)...)
+	jc.leftColumns = append(jc.leftColumns, leftDf.RejectColumns(leftNames...)...)
+	jc.rightColumns = append(jc.rightColumns, rightDf.RejectColumns(rightNames...)...)
 
 	// Keep track of the lengths. Now that we have appended the other columns.
 	jc.additionalLeftColsLen = len(jc.leftColumns) - jc.matchingLeftColsLen
@@ -239,8 +421,14 @@ func (m *Mutator) newJoinFuncConfig(cfg *leftJoinConfig, leftDf *DataFrame, righ
 
 	// get all the fields that make up the schema
 	fields := make([]arrow.Field, 0, jc.matchingLeftColsLen+jc.additionalLeftColsLen+jc.additionalRightColsLen)
-	for i := 0; i < len(jc.leftColumns); i++ {
-		fields = append(fields, jc.leftColumns[i].Field())
+	fields = append(fields, keyFields...)
+	for i := jc.matchingLeftColsLen; i < len(jc.leftColumns); i++ {
+		field := jc.leftColumns[i].Field()
+		if cfg.outer {
+			// This column's values must be nullable since there may not be any matches.
+			field.Nullable = true
+		}
+		fields = append(fields, field)
 	}
 	for i := jc.matchingRightColsLen; i < len(jc.rightColumns); i++ {
 		fcopy := jc.rightColumns[i].Field()
@@ -262,7 +450,9 @@ func (m *Mutator) newJoinFuncConfig(cfg *leftJoinConfig, leftDf *DataFrame, righ
 		fields = append(fields, fcopy)
 	}
 
-	jc.schema = arrow.NewSchema(fields, nil)
+	// The left schema metadata wins when both sides have the same key.
+	md := metadata.Merge(rightDf.Metadata(), leftDf.Metadata())
+	jc.schema = arrow.NewSchema(fields, &md)
 	jc.recordBuilder = array.NewRecordBuilder(m.mem, jc.schema)
 	jc.smartBuilder = smartbuilder.NewSmartBuilder(jc.recordBuilder)
 
@@ -270,7 +460,23 @@ func (m *Mutator) newJoinFuncConfig(cfg *leftJoinConfig, leftDf *DataFrame, righ
 }
 
 func (jc *joinFuncConfig) Release() {
-	jc.recordBuilder.Release()
+	if jc.recordBuilder != nil {
+		jc.recordBuilder.Release()
+	}
+	for _, col := range jc.ownedColumns {
+		col.Release()
+	}
+	jc.ownedColumns = nil
+}
+
+// rowsMatch returns true when the key columns of the left and right rows are equal.
+func (jc *joinFuncConfig) rowsMatch(left *iterator.StepValue, right *iterator.StepValue) bool {
+	for columnIndex := range jc.columnNames {
+		if !stepValueEqAt(left, right, columnIndex, jc.nullSafe) {
+			return false
+		}
+	}
+	return true
 }
 
 func (jc *joinFuncConfig) buildDataFrame() (*DataFrame, error) {
@@ -324,16 +530,7 @@ func sharedLeftJoinLogic(data *joinFuncConfig, iterationEndFunc func(bool, *iter
 			defer rightMatchingIterator.Release()
 			for rightMatchingIterator.Next() { // Iterate through every row in the right df.
 				rightStepValues := rightMatchingIterator.Values()
-				match := true
-
-				// For each matching column,
-				// check if the row on the left,
-				// matches with the rows on the right.
-				for columnIndex := range data.columnNames {
-					match = match && stepValueEqAt(leftStepValues, rightStepValues, columnIndex)
-				}
-
-				if match {
+				if data.rowsMatch(leftStepValues, rightStepValues) {
 					// For each match, we append a new row with the
 					// left columns values and the additional right column values.
 					appendEmptyRow = false
@@ -364,7 +561,7 @@ func sharedLeftJoinLogic(data *joinFuncConfig, iterationEndFunc func(bool, *iter
 // Acts like SQL in that nil elements are treated as unknown so nil != nil.
 func (m *Mutator) InnerJoin(rightDf *DataFrame, columnNames []string, opts ...Option) MutationFunc {
 	cfg, err := newLeftJoinConfig(opts...)
-	return func(leftDf *DataFrame) (*DataFrame, error) {
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
 		if err != nil {
 			return nil, err
 		}
@@ -379,7 +576,7 @@ func (m *Mutator) InnerJoin(rightDf *DataFrame, columnNames []string, opts ...Op
 		sharedLeftJoinLogic(data, func(bool, *iterator.StepValue) {})
 
 		return data.buildDataFrame()
-	}
+	})
 }
 
 // OuterJoin returns a DataFrame containing the outer join of two DataFrames.
@@ -387,7 +584,11 @@ func (m *Mutator) InnerJoin(rightDf *DataFrame, columnNames []string, opts ...Op
 // Acts like SQL in that nil elements are treated as unknown so nil != nil.
 func (m *Mutator) OuterJoin(rightDf *DataFrame, columnNames []string, opts ...Option) MutationFunc {
 	cfg, err := newLeftJoinConfig(opts...)
-	return func(leftDf *DataFrame) (*DataFrame, error) {
+	if err == nil {
+		cfg.outer = true
+	}
+
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
 		if err != nil {
 			return nil, err
 		}
@@ -432,7 +633,7 @@ func (m *Mutator) OuterJoin(rightDf *DataFrame, columnNames []string, opts ...Op
 		}
 
 		return data.buildDataFrame()
-	}
+	})
 }
 
 func outerJoinAnyRowsMatch(rightStepValues *iterator.StepValue, data *joinFuncConfig) bool {
@@ -440,16 +641,86 @@ func outerJoinAnyRowsMatch(rightStepValues *iterator.StepValue, data *joinFuncCo
 	defer leftIterator.Release()
 	for leftIterator.Next() { // Iterate through every row in the left df.
 		leftStepValues := leftIterator.Values()
-		match := true
+		if data.rowsMatch(leftStepValues, rightStepValues) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SemiJoin returns a DataFrame containing the rows of the left DataFrame that match
+// at least one row of the right DataFrame. Each left row is kept at most once and
+// none of the right columns are added.
+// Acts like SQL in that nil elements are treated as unknown so nil != nil.
+func (m *Mutator) SemiJoin(rightDf *DataFrame, columnNames []string, opts ...Option) MutationFunc {
+	cfg, err := newLeftJoinConfig(opts...)
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
+		if err != nil {
+			return nil, err
+		}
+		return m.semiJoin(cfg, leftDf, rightDf, columnNames, true)
+	})
+}
 
-		// For each matching column,
-		// check if the row on the left,
-		// matches with the rows on the right.
-		for columnIndex := range data.columnNames {
-			match = match && stepValueEqAt(leftStepValues, rightStepValues, columnIndex)
+// AntiJoin returns a DataFrame containing the rows of the left DataFrame that don't match
+// any row of the right DataFrame. None of the right columns are added.
+// Acts like SQL in that nil elements are treated as unknown so nil != nil,
+// which means left rows with a nil key are always kept.
+func (m *Mutator) AntiJoin(rightDf *DataFrame, columnNames []string, opts ...Option) MutationFunc {
+	cfg, err := newLeftJoinConfig(opts...)
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
+		if err != nil {
+			return nil, err
 		}
+		return m.semiJoin(cfg, leftDf, rightDf, columnNames, false)
+	})
+}
 
-		if match {
+// This semiJoin implementation is shared by both SemiJoin and AntiJoin.
+// It keeps the left rows for which having a match equals keepMatches.
+func (m *Mutator) semiJoin(cfg *leftJoinConfig, leftDf *DataFrame, rightDf *DataFrame, columnNames []string, keepMatches bool) (*DataFrame, error) {
+	data, err := m.newJoinFuncConfig(cfg, leftDf, rightDf, columnNames, false)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Release()
+
+	// The result has the same schema as the left DataFrame,
+	// so we build it from the left columns in their original order.
+	schema := leftDf.Schema()
+	recordBuilder := array.NewRecordBuilder(m.mem, schema)
+	defer recordBuilder.Release()
+	smartBuilder := smartbuilder.NewSmartBuilder(recordBuilder)
+
+	// The keys in data.leftColumns come first and may be dictionary indices,
+	// so we step through them together with the left columns we copy.
+	leftMatchingIterator := iterator.NewStepIteratorForColumns(data.leftColumns[:data.matchingLeftColsLen])
+	defer leftMatchingIterator.Release()
+	leftIterator := iterator.NewStepIteratorForColumns(leftDf.Columns())
+	defer leftIterator.Release()
+	for leftMatchingIterator.Next() && leftIterator.Next() { // Iterate through every row in the left df.
+		if semiJoinAnyRowsMatch(leftMatchingIterator.Values(), data) != keepMatches {
+			continue
+		}
+
+		leftStepValues := leftIterator.Values()
+		for i := range leftStepValues.Values {
+			smartBuilder.Append(i, leftStepValues.Values[i])
+		}
+	}
+
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+	return NewDataFrame(m.mem, schema, rec.Columns())
+}
+
+func semiJoinAnyRowsMatch(leftStepValues *iterator.StepValue, data *joinFuncConfig) bool {
+	rightIterator := iterator.NewStepIteratorForColumns(data.rightColumns[:data.matchingRightColsLen])
+	defer rightIterator.Release()
+	for rightIterator.Next() { // Iterate through every row in the right df.
+		rightStepValues := rightIterator.Values()
+		if data.rowsMatch(leftStepValues, rightStepValues) {
 			return true
 		}
 	}
@@ -460,52 +731,105 @@ func outerJoinAnyRowsMatch(rightStepValues *iterator.StepValue, data *joinFuncCo
 // CrossJoin returns a DataFrame containing the cross join of two DataFrames.
 func (m *Mutator) CrossJoin(rightDf *DataFrame, opts ...Option) MutationFunc {
 	cfg, err := newLeftJoinConfig(opts...)
-	return func(leftDf *DataFrame) (*DataFrame, error) {
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
 		if err != nil {
 			return nil, err
 		}
+		return m.crossJoin(cfg, leftDf, rightDf, nil)
+	})
+}
 
-		data, err := m.newJoinFuncConfig(cfg, leftDf, rightDf, nil, false)
+// ConditionJoin returns a DataFrame containing every combination of a row of the left DataFrame
+// and a row of the right DataFrame for which cond returns true, like a SQL inner join with an ON clause.
+// This allows non-equi joins such as range joins:
+//
+//	df.ConditionJoin(right, Between("ts", "start", "end"))
+//
+// All the columns of both DataFrames are kept.
+func (m *Mutator) ConditionJoin(rightDf *DataFrame, cond JoinCondition, opts ...Option) MutationFunc {
+	cfg, err := newLeftJoinConfig(opts...)
+	return mutation(func(leftDf *DataFrame) (*DataFrame, error) {
 		if err != nil {
 			return nil, err
 		}
-		defer data.Release()
+		if cond == nil {
+			return nil, fmt.Errorf("bullseye/mutations: nil join condition")
+		}
+		return m.crossJoin(cfg, leftDf, rightDf, cond)
+	})
+}
 
-		leftMatchingIterator := iterator.NewStepIteratorForColumns(data.leftColumns)
-		defer leftMatchingIterator.Release()
-		for leftMatchingIterator.Next() { // Iterate through every row in the left df.
-			leftStepValues := leftMatchingIterator.Values()
+// This crossJoin implementation is shared by both CrossJoin and ConditionJoin.
+// A nil cond joins every combination of rows.
+func (m *Mutator) crossJoin(cfg *leftJoinConfig, leftDf *DataFrame, rightDf *DataFrame, cond JoinCondition) (*DataFrame, error) {
+	if len(cfg.leftOn) > 0 {
+		return nil, fmt.Errorf("bullseye/mutations: leftOn (%v) and rightOn (%v) cannot be used without key columns", cfg.leftOn, cfg.rightOn)
+	}
 
-			func() {
-				rightMatchingIterator := iterator.NewStepIteratorForColumns(data.rightColumns)
-				defer rightMatchingIterator.Release()
-				for rightMatchingIterator.Next() { // Iterate through every row in the right df.
-					rightStepValues := rightMatchingIterator.Values()
+	data, err := m.newJoinFuncConfig(cfg, leftDf, rightDf, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Release()
 
-					cIdx := 0
+	// Without key columns the columns are in the same order as in each DataFrame.
+	leftRow := JoinRow{df: leftDf}
+	rightRow := JoinRow{df: rightDf}
 
-					// Add all columns from both frames.
-					for i := range leftStepValues.Values {
-						data.smartBuilder.Append(cIdx, leftStepValues.Values[i])
-						cIdx++
+	leftMatchingIterator := iterator.NewStepIteratorForColumns(data.leftColumns)
+	defer leftMatchingIterator.Release()
+	for leftMatchingIterator.Next() { // Iterate through every row in the left df.
+		leftStepValues := leftMatchingIterator.Values()
+		leftRow.values = leftStepValues
+
+		err := func() error {
+			rightMatchingIterator := iterator.NewStepIteratorForColumns(data.rightColumns)
+			defer rightMatchingIterator.Release()
+			for rightMatchingIterator.Next() { // Iterate through every row in the right df.
+				rightStepValues := rightMatchingIterator.Values()
+
+				if cond != nil {
+					rightRow.values = rightStepValues
+					match, err := cond(leftRow, rightRow)
+					if err != nil {
+						return err
 					}
-					for i := range rightStepValues.Values {
-						data.smartBuilder.Append(cIdx, rightStepValues.Values[i])
-						cIdx++
+					if !match {
+						continue
 					}
 				}
-			}()
-		}
 
-		return data.buildDataFrame()
+				cIdx := 0
+
+				// Add all columns from both frames.
+				for i := range leftStepValues.Values {
+					data.smartBuilder.Append(cIdx, leftStepValues.Values[i])
+					cIdx++
+				}
+				for i := range rightStepValues.Values {
+					data.smartBuilder.Append(cIdx, rightStepValues.Values[i])
+					cIdx++
+				}
+			}
+			return nil
+		}()
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	return data.buildDataFrame()
 }
 
-func stepValueEqAt(left *iterator.StepValue, right *iterator.StepValue, i int) bool {
+func stepValueEqAt(left *iterator.StepValue, right *iterator.StepValue, i int, nullSafe bool) bool {
 	lElem := StepValueElementAt(left, i)
 	rElem := StepValueElementAt(right, i)
 
-	v, err := lElem.Eq(rElem)
+	eq := lElem.Eq
+	if nullSafe {
+		eq = lElem.EqStrict
+	}
+	v, err := eq(rElem)
 	if err != nil {
 		panic(err)
 	}
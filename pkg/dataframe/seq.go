@@ -0,0 +1,43 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"iter"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/iterator"
+)
+
+// Rows returns a sequence of the rows in the DataFrame and their values for use with range.
+// The underlying iterators are released when the loop ends, even when it is broken out of.
+//
+//	for i, row := range df.Rows() {
+//		fmt.Println(i, row.Values)
+//	}
+func (df *DataFrame) Rows() iter.Seq2[int64, *iterator.StepValue] {
+	return iterator.Rows(df.Columns())
+}
+
+// Chunks returns a sequence of the chunks of the column with the given name, keyed by the row
+// each chunk starts at, for use with range. The sequence is empty when the column does not exist.
+// The underlying iterator is released when the loop ends, even when it is broken out of.
+func (df *DataFrame) Chunks(name string) iter.Seq2[int64, array.Interface] {
+	col := df.Column(name)
+	if col == nil {
+		return func(func(int64, array.Interface) bool) {}
+	}
+	return iterator.Chunks(col)
+}
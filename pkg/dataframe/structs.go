@@ -0,0 +1,387 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/object"
+	"github.com/gomem/gomem/pkg/smartbuilder"
+)
+
+// NewDataFrameFromStructs creates a new DataFrame from a slice of Go structs, ie. []T or []*T.
+// Each exported field of T becomes a column. The column name and nullability can be set
+// with a struct tag like `arrow:"name,nullable"` and fields tagged with `arrow:"-"` are skipped.
+// Pointers become nullable columns, nested structs become struct columns, slices become lists,
+// maps become map columns and time.Time becomes a timestamp.
+func NewDataFrameFromStructs(mem memory.Allocator, values interface{}) (*DataFrame, error) {
+	rv := reflect.ValueOf(values)
+	if rv.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("dataframe: NewDataFrameFromStructs wants a slice of structs, got %T", values)
+	}
+
+	schema, err := smartbuilder.SchemaOf(rv.Type().Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	recordBuilder := array.NewRecordBuilder(mem, schema)
+	defer recordBuilder.Release()
+
+	smartBuilder := smartbuilder.NewSmartBuilder(recordBuilder)
+	for i := 0; i < rv.Len(); i++ {
+		if err := smartBuilder.AppendStruct(rv.Index(i).Interface()); err != nil {
+			return nil, fmt.Errorf("dataframe: element %d: %w", i, err)
+		}
+	}
+
+	record := recordBuilder.NewRecord()
+	defer record.Release()
+
+	return NewDataFrameFromRecord(mem, record)
+}
+
+// ToStructs decodes the rows of the DataFrame into dst, which must be a pointer to
+// a slice of structs, ie. *[]T or *[]*T. Columns are matched to the exported fields
+// of T by their `arrow` tag or name, see NewDataFrameFromStructs.
+// Values are converted using the object.CastTo* functions. Null values become
+// nil pointers or zero values. Fields without a column are left as their zero value.
+func (df *DataFrame) ToStructs(dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("dataframe: ToStructs wants a pointer to a slice of structs, got %T", dst)
+	}
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+
+	dec, err := newStructDecoder(df, elemType)
+	if err != nil {
+		return err
+	}
+	defer dec.release()
+
+	out := reflect.MakeSlice(slice.Type(), int(df.NumRows()), int(df.NumRows()))
+	for row := 0; row < out.Len(); row++ {
+		if err := dec.decodeRow(out.Index(row), int64(row)); err != nil {
+			return err
+		}
+	}
+	slice.Set(out)
+	return nil
+}
+
+// Scan decodes row i of the DataFrame into dst, which must be a pointer to a struct.
+// Columns are matched to fields the same way as ToStructs.
+func (df *DataFrame) Scan(i int64, dst interface{}) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("dataframe: Scan wants a pointer to a struct, got %T", dst)
+	}
+	if i < 0 || i >= df.NumRows() {
+		return fmt.Errorf("dataframe: row %d out of range [0, %d)", i, df.NumRows())
+	}
+
+	sliceDf, err := df.Slice(i, i+1)
+	if err != nil {
+		return err
+	}
+	defer sliceDf.Release()
+
+	dec, err := newStructDecoder(sliceDf, rv.Elem().Type())
+	if err != nil {
+		return err
+	}
+	defer dec.release()
+
+	dec.offset = i
+	return dec.decodeRow(rv.Elem(), 0)
+}
+
+// structDecoder decodes DataFrame rows into Go structs.
+type structDecoder struct {
+	names     []string                 // column names
+	fields    []int                    // index of the Go struct field for each column
+	iterators []iterator.ValueIterator // iterator for each column
+	offset    int64                    // row offset used when reporting errors
+}
+
+func newStructDecoder(df *DataFrame, t reflect.Type) (*structDecoder, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("dataframe: cannot decode rows into %s, want a struct", t)
+	}
+
+	dec := &structDecoder{}
+	for _, sf := range smartbuilder.StructFields(t) {
+		i := matchName(df.ColumnNames(), sf.Name)
+		if i < 0 {
+			continue
+		}
+		col := df.ColumnAt(i)
+		dec.names = append(dec.names, col.Name())
+		dec.fields = append(dec.fields, sf.Index)
+		dec.iterators = append(dec.iterators, iterator.NewValueIterator(col))
+	}
+	return dec, nil
+}
+
+// decodeRow decodes the next row into dst, row is only used when reporting errors.
+func (dec *structDecoder) decodeRow(dst reflect.Value, row int64) error {
+	if dst.Kind() == reflect.Ptr {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		dst = dst.Elem()
+	}
+	for i, it := range dec.iterators {
+		if !it.Next() {
+			return fmt.Errorf("dataframe: column %q has no row %d", dec.names[i], row+dec.offset)
+		}
+		if err := decodeValue(dst.Field(dec.fields[i]), it); err != nil {
+			return fmt.Errorf("dataframe: column %q, row %d: %w", dec.names[i], row+dec.offset, err)
+		}
+	}
+	return nil
+}
+
+func (dec *structDecoder) release() {
+	for i := range dec.iterators {
+		dec.iterators[i].Release()
+	}
+	dec.iterators = nil
+}
+
+// decodeValue decodes the current value of the iterator into dst.
+func decodeValue(dst reflect.Value, it iterator.ValueIterator) error {
+	switch it := it.(type) {
+	case *iterator.UnionValueIterator:
+		member := it.ValueIterator()
+		if member == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+			return nil
+		}
+		return decodeValue(dst, member)
+
+	case *iterator.MapValueIterator:
+		keys, values := it.KeyValueIterators()
+		if keys == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+			return nil
+		}
+		defer keys.Release()
+		defer values.Release()
+		dst = allocValue(dst)
+		if dst.Kind() != reflect.Map {
+			return fmt.Errorf("cannot decode map into %s", dst.Type())
+		}
+		m := reflect.MakeMap(dst.Type())
+		for keys.Next() && values.Next() {
+			key := reflect.New(dst.Type().Key()).Elem()
+			if err := decodeValue(key, keys); err != nil {
+				return err
+			}
+			value := reflect.New(dst.Type().Elem()).Elem()
+			if err := decodeValue(value, values); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, value)
+		}
+		dst.Set(m)
+		return nil
+
+	case *iterator.ListValueIterator:
+		elems := it.ValueIterator()
+		if elems == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+			return nil
+		}
+		defer elems.Release()
+		dst = allocValue(dst)
+		switch dst.Kind() {
+		case reflect.Slice:
+			s := reflect.MakeSlice(dst.Type(), 0, 0)
+			for elems.Next() {
+				elem := reflect.New(dst.Type().Elem()).Elem()
+				if err := decodeValue(elem, elems); err != nil {
+					return err
+				}
+				s = reflect.Append(s, elem)
+			}
+			dst.Set(s)
+		case reflect.Array:
+			for i := 0; elems.Next(); i++ {
+				if i >= dst.Len() {
+					return fmt.Errorf("cannot decode more than %d elements into %s", dst.Len(), dst.Type())
+				}
+				if err := decodeValue(dst.Index(i), elems); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("cannot decode list into %s", dst.Type())
+		}
+		return nil
+
+	case *iterator.StructValueIterator:
+		if it.ValueInterface() == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+			return nil
+		}
+		dst = allocValue(dst)
+		if dst.Kind() != reflect.Struct {
+			return fmt.Errorf("cannot decode struct into %s", dst.Type())
+		}
+		fields := it.DataType().(*arrow.StructType).Fields()
+		names := make([]string, len(fields))
+		for i, field := range fields {
+			names[i] = field.Name
+		}
+		for _, sf := range smartbuilder.StructFields(dst.Type()) {
+			i := matchName(names, sf.Name)
+			if i < 0 {
+				continue
+			}
+			if err := decodeValue(dst.Field(sf.Index), it.FieldAt(i)); err != nil {
+				return fmt.Errorf("field %q: %w", sf.Name, err)
+			}
+		}
+		return nil
+
+	default:
+		return decodeScalar(dst, it.DataType(), it.ValueInterface())
+	}
+}
+
+// decodeScalar converts v, a value of the DataType dtype, into dst.
+func decodeScalar(dst reflect.Value, dtype arrow.DataType, v interface{}) error {
+	if v == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	dst = allocValue(dst)
+
+	rv := reflect.ValueOf(v)
+	if rv.Type().AssignableTo(dst.Type()) {
+		dst.Set(rv)
+		return nil
+	}
+	if dst.Type() == timeType {
+		t, ok := timeOf(dtype, v)
+		if !ok {
+			return fmt.Errorf("cannot convert %s to %s", dtype, dst.Type())
+		}
+		dst.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	obj, ok := object.CastToDataType(dtype, v)
+	if !ok {
+		return fmt.Errorf("cannot convert %T to an object", v)
+	}
+	switch dst.Kind() {
+	case reflect.Bool:
+		if b, ok := object.CastToBoolean(obj); ok {
+			dst.SetBool(bool(b))
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i, ok := object.CastToInt64(obj); ok && !dst.OverflowInt(int64(i)) {
+			dst.SetInt(int64(i))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if u, ok := object.CastToUint64(obj); ok && !dst.OverflowUint(uint64(u)) {
+			dst.SetUint(uint64(u))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, ok := object.CastToFloat64(obj); ok && !dst.OverflowFloat(float64(f)) {
+			dst.SetFloat(float64(f))
+			return nil
+		}
+	case reflect.String:
+		if s, ok := object.CastToString(obj); ok {
+			dst.SetString(string(s))
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot convert %v (%s) to %s", v, dtype, dst.Type())
+}
+
+// matchName returns the index of name in names, falling back
+// to a case-insensitive match. It returns -1 if there is no match.
+func matchName(names []string, name string) int {
+	for i := range names {
+		if names[i] == name {
+			return i
+		}
+	}
+	for i := range names {
+		if strings.EqualFold(names[i], name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// allocValue returns the value dst points to, allocating it when dst is a pointer.
+func allocValue(dst reflect.Value) reflect.Value {
+	if dst.Kind() != reflect.Ptr {
+		return dst
+	}
+	ptr := reflect.New(dst.Type().Elem())
+	dst.Set(ptr)
+	return ptr.Elem()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// timeOf converts a temporal value into a time.Time.
+func timeOf(dtype arrow.DataType, v interface{}) (time.Time, bool) {
+	switch dt := dtype.(type) {
+	case *arrow.TimestampType:
+		ts, ok := v.(arrow.Timestamp)
+		if !ok {
+			return time.Time{}, false
+		}
+		switch dt.Unit {
+		case arrow.Second:
+			return time.Unix(int64(ts), 0).UTC(), true
+		case arrow.Millisecond:
+			return time.Unix(0, int64(ts)*int64(time.Millisecond)).UTC(), true
+		case arrow.Microsecond:
+			return time.Unix(0, int64(ts)*int64(time.Microsecond)).UTC(), true
+		default:
+			return time.Unix(0, int64(ts)).UTC(), true
+		}
+	case *arrow.Date32Type:
+		d, ok := v.(arrow.Date32)
+		return time.Unix(int64(d)*24*60*60, 0).UTC(), ok
+	case *arrow.Date64Type:
+		d, ok := v.(arrow.Date64)
+		return time.Unix(0, int64(d)*int64(time.Millisecond)).UTC(), ok
+	default:
+		return time.Time{}, false
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gomem/gomem/pkg/gomemtest"
+)
+
+type testAddress struct {
+	City string `arrow:"city"`
+	Zip  *int32 `arrow:"zip"`
+}
+
+type testPerson struct {
+	Name     string             `arrow:"name"`
+	Age      int                `arrow:"age,nullable"`
+	Nickname *string            `arrow:"nickname"`
+	Address  testAddress        `arrow:"address"`
+	Tags     []string           `arrow:"tags"`
+	Scores   map[string]float64 `arrow:"scores"`
+	Born     time.Time          `arrow:"born"`
+	Secret   string             `arrow:"-"`
+	internal int
+}
+
+func TestNewDataFrameFromStructs(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	nickname := "bobby"
+	zip := int32(94107)
+	people := []testPerson{
+		{
+			Name:     "bob",
+			Age:      30,
+			Nickname: &nickname,
+			Address:  testAddress{City: "sf", Zip: &zip},
+			Tags:     []string{"a", "b"},
+			Scores:   map[string]float64{"math": 90, "art": 80.5},
+			Born:     time.Date(1990, 1, 2, 3, 4, 5, 0, time.UTC),
+			Secret:   "hidden",
+			internal: 1,
+		},
+		{
+			Name:    "alice",
+			Age:     25,
+			Address: testAddress{City: "nyc"},
+			Born:    time.Unix(0, 0),
+		},
+	}
+
+	df, err := NewDataFrameFromStructs(pool, people)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	if got, want := df.ColumnNames(), []string{"name", "age", "nickname", "address", "tags", "scores", "born"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	wantNullable := map[string]bool{"name": false, "age": true, "nickname": true, "address": false, "tags": true, "scores": true, "born": false}
+	for _, field := range df.Schema().Fields() {
+		if got, want := field.Nullable, wantNullable[field.Name]; got != want {
+			t.Fatalf("%s: got=%v, want=%v", field.Name, got, want)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := df.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"address":{"city":"sf","zip":94107},"age":30,"born":631249445000000000,"name":"bob","nickname":"bobby","scores":{"art":80.5,"math":90},"tags":["a","b"]}
+{"address":{"city":"nyc","zip":null},"age":25,"born":0,"name":"alice","nickname":null,"scores":null,"tags":null}
+`
+	if got := b.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	ptrDf, err := NewDataFrameFromStructs(pool, []*testPerson{&people[0], &people[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ptrDf.Release()
+	b.Reset()
+	if err := ptrDf.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+
+	if _, err := NewDataFrameFromStructs(pool, []*testPerson{nil}); err == nil {
+		t.Fatal("expected an error for a nil element")
+	}
+	if _, err := NewDataFrameFromStructs(pool, []int{1, 2}); err == nil {
+		t.Fatal("expected an error for a slice of non-structs")
+	}
+}
+
+func TestNewDataFrameFromMemStructs(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	df, err := NewDataFrameFromMem(pool, Dict{
+		"A": []*testAddress{{City: "sf"}, nil},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	var b bytes.Buffer
+	if err := df.ToJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"A":{"city":"sf","zip":null}}
+{"A":null}
+`
+	if got := b.String(); got != want {
+		t.Fatalf("\ngot=\n%v\nwant=\n%v", got, want)
+	}
+}
+
+func TestToStructs(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	nickname := "bobby"
+	zip := int32(94107)
+	people := []testPerson{
+		{
+			Name:     "bob",
+			Age:      30,
+			Nickname: &nickname,
+			Address:  testAddress{City: "sf", Zip: &zip},
+			Tags:     []string{"a", "b"},
+			Scores:   map[string]float64{"math": 90, "art": 80.5},
+			Born:     time.Date(1990, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			Name:    "alice",
+			Age:     25,
+			Address: testAddress{City: "nyc"},
+			Born:    time.Unix(0, 0).UTC(),
+		},
+	}
+
+	df, err := NewDataFrameFromStructs(pool, people)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer df.Release()
+
+	var got []testPerson
+	if err := df.ToStructs(&got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, people) {
+		t.Fatalf("\ngot=\n%+v\nwant=\n%+v", got, people)
+	}
+
+	var gotPtrs []*testPerson
+	if err := df.ToStructs(&gotPtrs); err != nil {
+		t.Fatal(err)
+	}
+	if len(gotPtrs) != len(people) || !reflect.DeepEqual(*gotPtrs[1], people[1]) {
+		t.Fatalf("\ngot=\n%+v\nwant=\n%+v", gotPtrs, people)
+	}
+
+	var person testPerson
+	if err := df.Scan(1, &person); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(person, people[1]) {
+		t.Fatalf("\ngot=\n%+v\nwant=\n%+v", person, people[1])
+	}
+	if err := df.Scan(2, &person); err == nil {
+		t.Fatal("expected an error scanning a row out of range")
+	}
+
+	// Columns are matched by name when there is no tag and values
+	// are converted to the field type.
+	type narrow struct {
+		NAME string
+		Age  int8
+		Zip  *int64 `arrow:"nickname"`
+	}
+	var narrowed []narrow
+	err = df.ToStructs(&narrowed)
+	if err == nil {
+		t.Fatal("expected an error converting a string column into an integer field")
+	}
+	if got, want := err.Error(), `dataframe: column "nickname", row 0: cannot convert bobby (utf8) to int64`; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	type renamed struct {
+		NAME string
+		Age  int8
+		Nick *string `arrow:"nickname"`
+	}
+	var renamedRows []renamed
+	if err := df.ToStructs(&renamedRows); err != nil {
+		t.Fatal(err)
+	}
+	want := []renamed{{NAME: "bob", Age: 30, Nick: &nickname}, {NAME: "alice", Age: 25}}
+	if !reflect.DeepEqual(renamedRows, want) {
+		t.Fatalf("\ngot=\n%+v\nwant=\n%+v", renamedRows, want)
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/logical"
+)
+
+// sampleConfig are the config params for Sample and SampleFrac.
+type sampleConfig struct {
+	withReplacement bool
+}
+
+// WithReplacement configures Sample and SampleFrac to draw rows with replacement,
+// so the same row can be drawn more than once.
+func WithReplacement() Option {
+	return func(p interface{}) error {
+		o, ok := p.(*sampleConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithReplacement to: %T", p)
+		}
+		o.withReplacement = true
+		return nil
+	}
+}
+
+// Head returns a DataFrame with the first n rows, or all of them when there are fewer than n.
+func (m *Mutator) Head(n int64) MutationFunc {
+	return func(df *DataFrame) (*DataFrame, error) {
+		if n < 0 {
+			return nil, fmt.Errorf("bullseye/take: n must not be negative: %d", n)
+		}
+		end := n
+		if rows := df.NumRows(); end > rows {
+			end = rows
+		}
+		return m.Slice(0, end)(df)
+	}
+}
+
+// Tail returns a DataFrame with the last n rows, or all of them when there are fewer than n.
+func (m *Mutator) Tail(n int64) MutationFunc {
+	return func(df *DataFrame) (*DataFrame, error) {
+		if n < 0 {
+			return nil, fmt.Errorf("bullseye/take: n must not be negative: %d", n)
+		}
+		rows := df.NumRows()
+		beg := rows - n
+		if beg < 0 {
+			beg = 0
+		}
+		return m.Slice(beg, rows)(df)
+	}
+}
+
+// Take returns a DataFrame with the rows at indices, in the order of indices.
+// An index can be repeated. Indices must not be null and must be in range.
+func (m *Mutator) Take(indices *array.Int64) MutationFunc {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		if indices.NullN() > 0 {
+			return nil, fmt.Errorf("bullseye/take: indices must not contain nulls")
+		}
+		return m.take(df, indices.Int64Values())
+	})
+}
+
+// Sample returns a DataFrame with n rows drawn at random using seed.
+// The same seed always draws the same rows. Without replacement n must not be
+// more than the number of rows. Use WithReplacement to draw with replacement.
+func (m *Mutator) Sample(n int64, seed int64, opts ...Option) MutationFunc {
+	cfg := &sampleConfig{}
+	var err error
+	for _, opt := range opts {
+		if err = opt(cfg); err != nil {
+			break
+		}
+	}
+
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		if err != nil {
+			return nil, err
+		}
+		return m.sample(df, n, seed, cfg)
+	})
+}
+
+// SampleFrac is the same as Sample only the number of rows drawn is frac of the
+// number of rows in the DataFrame, rounded to the nearest row.
+func (m *Mutator) SampleFrac(frac float64, seed int64, opts ...Option) MutationFunc {
+	cfg := &sampleConfig{}
+	var err error
+	for _, opt := range opts {
+		if err = opt(cfg); err != nil {
+			break
+		}
+	}
+
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		if err != nil {
+			return nil, err
+		}
+		if frac < 0 || math.IsNaN(frac) {
+			return nil, fmt.Errorf("bullseye/take: frac must not be negative: %v", frac)
+		}
+		n := int64(math.Round(frac * float64(df.NumRows())))
+		return m.sample(df, n, seed, cfg)
+	})
+}
+
+func (m *Mutator) sample(df *DataFrame, n int64, seed int64, cfg *sampleConfig) (*DataFrame, error) {
+	rows := df.NumRows()
+	if n < 0 {
+		return nil, fmt.Errorf("bullseye/take: n must not be negative: %d", n)
+	}
+	if !cfg.withReplacement && n > rows {
+		return nil, fmt.Errorf("bullseye/take: cannot sample %d rows without replacement from %d rows", n, rows)
+	}
+	if cfg.withReplacement && n > 0 && rows == 0 {
+		return nil, fmt.Errorf("bullseye/take: cannot sample %d rows from an empty DataFrame", n)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	indices := make([]int64, n)
+	if cfg.withReplacement {
+		for i := range indices {
+			indices[i] = rng.Int63n(rows)
+		}
+	} else {
+		// A partial Fisher-Yates shuffle only draws the n rows that are needed.
+		perm := make([]int64, rows)
+		for i := range perm {
+			perm[i] = int64(i)
+		}
+		for i := int64(0); i < n; i++ {
+			j := i + rng.Int63n(rows-i)
+			perm[i], perm[j] = perm[j], perm[i]
+		}
+		copy(indices, perm[:n])
+	}
+
+	return m.take(df, indices)
+}
+
+// take gathers the rows at indices from all the chunks of every column of df.
+func (m *Mutator) take(df *DataFrame, indices []int64) (*DataFrame, error) {
+	rows := df.NumRows()
+	for _, idx := range indices {
+		if idx < 0 || idx >= rows {
+			return nil, fmt.Errorf("bullseye/take: index %d out of range [0, %d)", idx, rows)
+		}
+	}
+
+	schema := df.Schema()
+	recordBuilder := array.NewRecordBuilder(m.mem, schema)
+	defer recordBuilder.Release()
+
+	for i, col := range df.Columns() {
+		chunks := col.Data().Chunks()
+		// ends holds the row after the last row of each chunk.
+		ends := make([]int64, len(chunks))
+		var end int64
+		for j, chunk := range chunks {
+			end += int64(chunk.Len())
+			ends[j] = end
+		}
+
+		appendValue := appendArrayValue
+		if union, ok := logical.UnionFromField(col.Field()); ok && union.Mode == logical.DenseMode {
+			appendValue = func(b array.Builder, arr array.Interface, i int) error {
+				return appendDenseUnionValue(b, union, arr, i)
+			}
+		}
+
+		b := recordBuilder.Field(i)
+		for _, idx := range indices {
+			j := sort.Search(len(ends), func(k int) bool { return ends[k] > idx })
+			row := idx - ends[j] + int64(chunks[j].Len())
+			if err := appendValue(b, chunks[j], int(row)); err != nil {
+				return nil, fmt.Errorf("bullseye/take: column %s: %w", col.Name(), err)
+			}
+		}
+	}
+
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+	return NewDataFrame(m.mem, schema, rec.Columns())
+}
+
+// appendArrayValue appends the value at index i of arr to b, which must be a builder for the type of arr.
+// Nested list, fixed size list and struct values are copied element by element.
+func appendArrayValue(b array.Builder, arr array.Interface, i int) error {
+	if arr.IsNull(i) {
+		appendNull(b, arr.DataType())
+		return nil
+	}
+
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		b.(*array.BooleanBuilder).Append(arr.Value(i))
+	case *array.Int8:
+		b.(*array.Int8Builder).Append(arr.Value(i))
+	case *array.Int16:
+		b.(*array.Int16Builder).Append(arr.Value(i))
+	case *array.Int32:
+		b.(*array.Int32Builder).Append(arr.Value(i))
+	case *array.Int64:
+		b.(*array.Int64Builder).Append(arr.Value(i))
+	case *array.Uint8:
+		b.(*array.Uint8Builder).Append(arr.Value(i))
+	case *array.Uint16:
+		b.(*array.Uint16Builder).Append(arr.Value(i))
+	case *array.Uint32:
+		b.(*array.Uint32Builder).Append(arr.Value(i))
+	case *array.Uint64:
+		b.(*array.Uint64Builder).Append(arr.Value(i))
+	case *array.Float16:
+		b.(*array.Float16Builder).Append(arr.Value(i))
+	case *array.Float32:
+		b.(*array.Float32Builder).Append(arr.Value(i))
+	case *array.Float64:
+		b.(*array.Float64Builder).Append(arr.Value(i))
+	case *array.Date32:
+		b.(*array.Date32Builder).Append(arr.Value(i))
+	case *array.Date64:
+		b.(*array.Date64Builder).Append(arr.Value(i))
+	case *array.Time32:
+		b.(*array.Time32Builder).Append(arr.Value(i))
+	case *array.Time64:
+		b.(*array.Time64Builder).Append(arr.Value(i))
+	case *array.Timestamp:
+		b.(*array.TimestampBuilder).Append(arr.Value(i))
+	case *array.Duration:
+		b.(*array.DurationBuilder).Append(arr.Value(i))
+	case *array.MonthInterval:
+		b.(*array.MonthIntervalBuilder).Append(arr.Value(i))
+	case *array.DayTimeInterval:
+		b.(*array.DayTimeIntervalBuilder).Append(arr.Value(i))
+	case *array.Decimal128:
+		b.(*array.Decimal128Builder).Append(arr.Value(i))
+	case *array.String:
+		b.(*array.StringBuilder).Append(arr.Value(i))
+	case *array.Binary:
+		b.(*array.BinaryBuilder).Append(arr.Value(i))
+	case *array.FixedSizeBinary:
+		b.(*array.FixedSizeBinaryBuilder).Append(arr.Value(i))
+	case *array.List:
+		lb := b.(*array.ListBuilder)
+		lb.Append(true)
+		// The offsets are not sliced with the array.
+		offsets := arr.Offsets()
+		pos := arr.Data().Offset() + i
+		for j := int(offsets[pos]); j < int(offsets[pos+1]); j++ {
+			if err := appendArrayValue(lb.ValueBuilder(), arr.ListValues(), j); err != nil {
+				return err
+			}
+		}
+	case *array.FixedSizeList:
+		lb := b.(*array.FixedSizeListBuilder)
+		lb.Append(true)
+		// The values are not sliced with the array.
+		n := int(arr.DataType().(*arrow.FixedSizeListType).Len())
+		beg := (arr.Data().Offset() + i) * n
+		for j := beg; j < beg+n; j++ {
+			if err := appendArrayValue(lb.ValueBuilder(), arr.ListValues(), j); err != nil {
+				return err
+			}
+		}
+	case *array.Struct:
+		sb := b.(*array.StructBuilder)
+		sb.Append(true)
+		for j := 0; j < arr.NumField(); j++ {
+			if err := appendArrayValue(sb.FieldBuilder(j), arr.Field(j), i); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported data type %s", arr.DataType())
+	}
+	return nil
+}
+
+// appendDenseUnionValue appends the value at index i of arr, a dense union, to b.
+// The value offsets of arr are positions in it's member lists, so they are rebuilt for b.
+func appendDenseUnionValue(b array.Builder, union logical.Union, arr array.Interface, i int) error {
+	if arr.IsNull(i) {
+		appendNull(b, arr.DataType())
+		return nil
+	}
+
+	src := arr.(*array.Struct)
+	sb := b.(*array.StructBuilder)
+	typeCode := src.Field(0).(*array.Int8).Value(i)
+	member := union.ChildIndex(typeCode)
+	if member < 0 {
+		return fmt.Errorf("unknown union type code %d", typeCode)
+	}
+	offset := int(src.Field(1).(*array.Int32).Value(i))
+
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.Int8Builder).Append(typeCode)
+	for j := range union.Members {
+		lb := sb.FieldBuilder(union.MemberFieldIndex(j)).(*array.ListBuilder)
+		if j != member {
+			lb.AppendNull()
+			continue
+		}
+		lb.Append(true)
+		sb.FieldBuilder(1).(*array.Int32Builder).Append(int32(lb.ValueBuilder().Len()))
+		values := src.Field(union.MemberFieldIndex(j)).(*array.List).ListValues()
+		if err := appendArrayValue(lb.ValueBuilder(), values, offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendNull appends a null to b, a builder for dtype.
+// A fixed size list builder doesn't append to its values for a null, so nulls are appended
+// to them to keep them aligned. A struct builder appends a null to each of its fields itself.
+func appendNull(b array.Builder, dtype arrow.DataType) {
+	b.AppendNull()
+	alignNullChildren(b, dtype)
+}
+
+// alignNullChildren appends the nulls missing from the children of b after a null was appended to it.
+func alignNullChildren(b array.Builder, dtype arrow.DataType) {
+	switch dtype := dtype.(type) {
+	case *arrow.StructType:
+		sb := b.(*array.StructBuilder)
+		for j, field := range dtype.Fields() {
+			alignNullChildren(sb.FieldBuilder(j), field.Type)
+		}
+	case *arrow.FixedSizeListType:
+		lb := b.(*array.FixedSizeListBuilder)
+		for j := int32(0); j < dtype.Len(); j++ {
+			appendNull(lb.ValueBuilder(), dtype.Elem())
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/logical"
+)
+
+// ValueCounts returns a DataFrame with the distinct values of the named column and how many rows hold each of them,
+// sorted by the count from most to least. Values with the same count stay in the order they first appear.
+// The columns are the named column and "count", or "proportion" holding the fraction of the rows when normalize is true.
+// Null values are counted as a value of their own unless dropNull is true.
+func (m *Mutator) ValueCounts(name string, normalize, dropNull bool) MutationFunc {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		col, err := valueColumn(df, name)
+		if err != nil {
+			return nil, err
+		}
+
+		groups := valueGroups(col)
+
+		if dropNull && groups.null >= 0 {
+			groups.first = append(groups.first[:groups.null], groups.first[groups.null+1:]...)
+			groups.counts = append(groups.counts[:groups.null], groups.counts[groups.null+1:]...)
+		}
+		sort.Stable(byCount(groups))
+
+		valuesDf, err := m.takeColumn(df, name, groups.first)
+		if err != nil {
+			return nil, err
+		}
+		defer valuesDf.Release()
+
+		var total int64
+		for _, count := range groups.counts {
+			total += count
+		}
+
+		countField := arrow.Field{Name: "count", Type: arrow.PrimitiveTypes.Int64}
+		var counts array.Interface
+		if normalize {
+			countField = arrow.Field{Name: "proportion", Type: arrow.PrimitiveTypes.Float64}
+			b := array.NewFloat64Builder(m.mem)
+			defer b.Release()
+			for _, count := range groups.counts {
+				b.Append(float64(count) / float64(total))
+			}
+			counts = b.NewArray()
+		} else {
+			b := array.NewInt64Builder(m.mem)
+			defer b.Release()
+			b.AppendValues(groups.counts, nil)
+			counts = b.NewArray()
+		}
+		defer counts.Release()
+
+		schema := arrow.NewSchema([]arrow.Field{valuesDf.Schema().Field(0), countField}, nil)
+		values := valuesDf.Columns()[0].Data().Chunk(0)
+		return NewDataFrame(m.mem, schema, []array.Interface{values, counts})
+	})
+}
+
+// Unique returns a DataFrame with the distinct values of the named column in the order they first appear.
+// A null value is kept as a value of its own.
+func (m *Mutator) Unique(name string) MutationFunc {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		col, err := valueColumn(df, name)
+		if err != nil {
+			return nil, err
+		}
+
+		groups := valueGroups(col)
+
+		return m.takeColumn(df, name, groups.first)
+	})
+}
+
+// Histogram returns a DataFrame counting the values of the named numeric column in bins of equal width
+// between the smallest and largest value. The columns are "start", "end" and "count".
+// Every bin holds the values from its start up to its end, and the last bin also holds its end.
+// Null, NaN and infinite values are not counted.
+func (m *Mutator) Histogram(name string, bins int) MutationFunc {
+	return mutation(func(df *DataFrame) (*DataFrame, error) {
+		if bins <= 0 {
+			return nil, fmt.Errorf("bullseye/histogram: bins must be positive: %d", bins)
+		}
+		col := df.Column(name)
+		if col == nil {
+			return nil, fmt.Errorf("bullseye/histogram: column %s is not in DataFrame: (%v)", name, df.ColumnNames())
+		}
+
+		values := make([]float64, 0, col.Len()-col.NullN())
+		it := iterator.NewValueIterator(col)
+		defer it.Release()
+		for it.Next() {
+			v := it.ValueInterface()
+			if v == nil {
+				continue
+			}
+			f, ok := histogramValue(v)
+			if !ok {
+				return nil, fmt.Errorf("bullseye/histogram: column %s is not numeric: %s", name, col.DataType())
+			}
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				continue
+			}
+			values = append(values, f)
+		}
+
+		// Like numpy, the range is [0, 1] without values and widened by a half when all the values are the same.
+		lo, hi := 0.0, 1.0
+		if len(values) > 0 {
+			lo, hi = values[0], values[0]
+			for _, v := range values {
+				lo = math.Min(lo, v)
+				hi = math.Max(hi, v)
+			}
+			if lo == hi {
+				lo, hi = lo-0.5, hi+0.5
+			}
+		}
+		width := (hi - lo) / float64(bins)
+
+		counts := make([]int64, bins)
+		for _, v := range values {
+			bin := int((v - lo) / width)
+			if bin < 0 {
+				bin = 0
+			}
+			if bin >= bins {
+				bin = bins - 1
+			}
+			counts[bin]++
+		}
+
+		schema := arrow.NewSchema(
+			[]arrow.Field{
+				{Name: "start", Type: arrow.PrimitiveTypes.Float64},
+				{Name: "end", Type: arrow.PrimitiveTypes.Float64},
+				{Name: "count", Type: arrow.PrimitiveTypes.Int64},
+			},
+			nil,
+		)
+		recordBuilder := array.NewRecordBuilder(m.mem, schema)
+		defer recordBuilder.Release()
+		for i := 0; i < bins; i++ {
+			end := lo + float64(i+1)*width
+			if i == bins-1 {
+				end = hi
+			}
+			recordBuilder.Field(0).(*array.Float64Builder).Append(lo + float64(i)*width)
+			recordBuilder.Field(1).(*array.Float64Builder).Append(end)
+		}
+		recordBuilder.Field(2).(*array.Int64Builder).AppendValues(counts, nil)
+
+		rec := recordBuilder.NewRecord()
+		defer rec.Release()
+		return NewDataFrame(m.mem, schema, rec.Columns())
+	})
+}
+
+// valueColumn returns the named column when its values can be grouped.
+func valueColumn(df *DataFrame, name string) (*array.Column, error) {
+	col := df.Column(name)
+	if col == nil {
+		return nil, fmt.Errorf("bullseye/valuecounts: column %s is not in DataFrame: (%v)", name, df.ColumnNames())
+	}
+	if logical.IsMap(col.Field()) || logical.IsUnion(col.Field()) {
+		return nil, fmt.Errorf("bullseye/valuecounts: unsupported data type for column %s: %s", name, col.DataType())
+	}
+	switch col.DataType().(type) {
+	case *arrow.ListType, *arrow.FixedSizeListType, *arrow.StructType:
+		return nil, fmt.Errorf("bullseye/valuecounts: unsupported data type for column %s: %s", name, col.DataType())
+	}
+	return col, nil
+}
+
+// valueGroupsResult holds the distinct values of a column in the order they first appear.
+type valueGroupsResult struct {
+	// first is the first row holding each value.
+	first []int64
+	// counts is how many rows hold each value.
+	counts []int64
+	// null is the index of the null value or -1 when there isn't one.
+	null int
+}
+
+// nanKey is the key of every NaN value so they are all in one group.
+type nanKey struct{}
+
+// valueGroups groups the rows of col by value using its typed iterator.
+// Dictionary columns are grouped by their decoded values and all NaN values are in one group.
+func valueGroups(col *array.Column) valueGroupsResult {
+	groups := valueGroupsResult{null: -1}
+	index := make(map[interface{}]int)
+
+	it := iterator.NewValueIterator(col)
+	defer it.Release()
+	for row := int64(0); it.Next(); row++ {
+		key := it.ValueInterface()
+		switch v := key.(type) {
+		case []byte:
+			key = string(v)
+		case float64:
+			if math.IsNaN(v) {
+				key = nanKey{}
+			}
+		case float32:
+			if math.IsNaN(float64(v)) {
+				key = nanKey{}
+			}
+		case float16.Num:
+			if math.IsNaN(float64(v.Float32())) {
+				key = nanKey{}
+			}
+		}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(groups.first)
+			index[key] = i
+			groups.first = append(groups.first, row)
+			groups.counts = append(groups.counts, 0)
+			if key == nil {
+				groups.null = i
+			}
+		}
+		groups.counts[i]++
+	}
+
+	return groups
+}
+
+// byCount sorts value groups by count from most to least.
+type byCount valueGroupsResult
+
+func (g byCount) Len() int           { return len(g.first) }
+func (g byCount) Less(i, j int) bool { return g.counts[i] > g.counts[j] }
+func (g byCount) Swap(i, j int) {
+	g.first[i], g.first[j] = g.first[j], g.first[i]
+	g.counts[i], g.counts[j] = g.counts[j], g.counts[i]
+}
+
+// takeColumn returns a DataFrame with only the named column holding the rows at indices.
+func (m *Mutator) takeColumn(df *DataFrame, name string, indices []int64) (*DataFrame, error) {
+	selected, err := m.Select(name)(df)
+	if err != nil {
+		return nil, err
+	}
+	defer selected.Release()
+	return m.take(selected, indices)
+}
+
+// histogramValue returns the numeric value v as a float64.
+func histogramValue(v interface{}) (float64, bool) {
+	switch t := v.(type) {
+	case int8:
+		return float64(t), true
+	case int16:
+		return float64(t), true
+	case int32:
+		return float64(t), true
+	case int64:
+		return float64(t), true
+	case uint8:
+		return float64(t), true
+	case uint16:
+		return float64(t), true
+	case uint32:
+		return float64(t), true
+	case uint64:
+		return float64(t), true
+	case float16.Num:
+		return float64(t.Float32()), true
+	case float32:
+		return float64(t), true
+	case float64:
+		return t, true
+	default:
+		return 0, false
+	}
+}
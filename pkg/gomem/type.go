@@ -59,3 +59,60 @@ func (t GomemType) Collection() collection.Collection {
 func (t GomemType) Logical() logical.Logical {
 	return t.l
 }
+
+// Len returns the number of values, 1 for an Object.
+func (t GomemType) Len() int {
+	switch t.t {
+	case ObjectType:
+		return 1
+	case CollectionType:
+		return t.c.Len()
+	case LogicalType:
+		return t.l.Len()
+	default:
+		return 0
+	}
+}
+
+// At returns the value at index i as an Object, nil when the value is null.
+func (t GomemType) At(i int) object.Object {
+	switch t.t {
+	case ObjectType:
+		if i != 0 {
+			panic(fmt.Sprintf("gomem: index %d out of range [0, 1)", i))
+		}
+		return t.o
+	case CollectionType:
+		return t.c.At(i)
+	case LogicalType:
+		return t.l.At(i)
+	default:
+		panic(fmt.Sprintf("gomem: index %d out of range [0, 0)", i))
+	}
+}
+
+// IsNull returns true when the value at index i is null.
+func (t GomemType) IsNull(i int) bool {
+	switch t.t {
+	case CollectionType:
+		return t.c.IsNull(i)
+	case LogicalType:
+		return t.l.IsNull(i)
+	default:
+		return t.At(i) == nil
+	}
+}
+
+// Iterator returns an Iterator over the values.
+func (t GomemType) Iterator() collection.Iterator {
+	switch t.t {
+	case CollectionType:
+		return t.c.Iterator()
+	case LogicalType:
+		return t.l.Iterator()
+	default:
+		return collection.NewIterator(t)
+	}
+}
+
+var _ collection.Reader = GomemType{}
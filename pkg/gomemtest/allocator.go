@@ -0,0 +1,219 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomemtest
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/internal/debug"
+)
+
+// maxFrames is the number of frames recorded for each allocation.
+const maxFrames = 32
+
+// CheckedAllocator is a memory.Allocator that keeps track of the outstanding allocations
+// along with the stack each of them was allocated from.
+// It is safe for concurrent use.
+type CheckedAllocator struct {
+	mem *memory.CheckedAllocator
+
+	mu     sync.Mutex
+	sz     int
+	allocs map[uintptr]allocation
+
+	// refs tracks the references to the objects holding memory from the allocator, see NewAllocator.
+	refs *debug.RefTracker
+}
+
+type allocation struct {
+	size int
+	pcs  []uintptr
+}
+
+// NewCheckedAllocator creates a new CheckedAllocator that allocates from mem.
+func NewCheckedAllocator(mem memory.Allocator) *CheckedAllocator {
+	return &CheckedAllocator{
+		mem:    memory.NewCheckedAllocator(mem),
+		allocs: make(map[uintptr]allocation),
+	}
+}
+
+// NewAllocator creates a new CheckedAllocator backed by the Go allocator
+// that fails t when the test finishes with memory still allocated.
+// When built with the debug tag it also tracks the references to the objects holding its memory
+// until the test finishes, so tests running in parallel each only report their own objects.
+func NewAllocator(t testing.TB) *CheckedAllocator {
+	t.Helper()
+	a := NewCheckedAllocator(memory.NewGoAllocator())
+	a.refs = debug.NewRefTracker(a.owns)
+	t.Cleanup(func() {
+		a.AssertSize(t, 0)
+		a.refs.Close()
+	})
+	return a
+}
+
+// Allocate allocates size bytes and records the stack of the caller.
+func (a *CheckedAllocator) Allocate(size int) []byte {
+	b := a.mem.Allocate(size)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sz += size
+	a.record(b)
+	return b
+}
+
+// Reallocate resizes b to size bytes and records the stack of the caller.
+func (a *CheckedAllocator) Reallocate(size int, b []byte) []byte {
+	a.mu.Lock()
+	a.forget(b)
+	a.mu.Unlock()
+
+	nb := a.mem.Reallocate(size, b)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sz += size - len(b)
+	a.record(nb)
+	return nb
+}
+
+// Free releases b.
+func (a *CheckedAllocator) Free(b []byte) {
+	a.mu.Lock()
+	a.sz -= len(b)
+	a.forget(b)
+	a.mu.Unlock()
+
+	a.mem.Free(b)
+}
+
+// CurrentAlloc returns the number of bytes that are currently allocated.
+func (a *CheckedAllocator) CurrentAlloc() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.sz
+}
+
+// AssertSize fails t when the number of bytes currently allocated is not sz.
+// The failure lists the stacks the outstanding allocations came from and,
+// when built with the debug tag, the objects that still have references.
+func (a *CheckedAllocator) AssertSize(t memory.TestingT, sz int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.sz == sz {
+		return
+	}
+
+	t.Helper()
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid memory size exp=%d, got=%d", sz, a.sz)
+	for _, out := range a.outstanding() {
+		fmt.Fprintf(&b, "\n%d bytes in %d allocations from:\n%s", out.size, out.count, out.stack)
+	}
+	if refs := a.refs.LiveRefs(); len(refs) > 0 {
+		fmt.Fprintf(&b, "\n%d objects with outstanding references:", len(refs))
+		for _, ref := range refs {
+			fmt.Fprintf(&b, "\n%s", ref)
+		}
+	}
+	t.Errorf("%s", b.String())
+}
+
+type outstanding struct {
+	stack string
+	size  int
+	count int
+}
+
+// outstanding groups the outstanding allocations by their stack, largest first.
+func (a *CheckedAllocator) outstanding() []outstanding {
+	byStack := make(map[string]*outstanding)
+	for _, alloc := range a.allocs {
+		stack := formatStack(alloc.pcs)
+		out, ok := byStack[stack]
+		if !ok {
+			out = &outstanding{stack: stack}
+			byStack[stack] = out
+		}
+		out.size += alloc.size
+		out.count++
+	}
+
+	outs := make([]outstanding, 0, len(byStack))
+	for _, out := range byStack {
+		outs = append(outs, *out)
+	}
+	sort.Slice(outs, func(i, j int) bool {
+		if outs[i].size != outs[j].size {
+			return outs[i].size > outs[j].size
+		}
+		return outs[i].stack < outs[j].stack
+	})
+	return outs
+}
+
+// owns returns true when addr is the start of memory that is currently allocated.
+func (a *CheckedAllocator) owns(addr uintptr) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	_, ok := a.allocs[addr]
+	return ok
+}
+
+func (a *CheckedAllocator) record(b []byte) {
+	pcs := make([]uintptr, maxFrames)
+	// Skip runtime.Callers, record and the allocator method.
+	n := runtime.Callers(3, pcs)
+	a.allocs[key(b)] = allocation{size: len(b), pcs: pcs[:n]}
+}
+
+func (a *CheckedAllocator) forget(b []byte) {
+	delete(a.allocs, key(b))
+}
+
+// key identifies an allocation by the address of its first byte.
+func key(b []byte) uintptr {
+	return uintptr(unsafe.Pointer(unsafe.SliceData(b)))
+}
+
+func formatStack(pcs []uintptr) string {
+	var b strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if frame.Function == "testing.tRunner" {
+			break
+		}
+		fmt.Fprintf(&b, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
+var (
+	_ memory.Allocator = (*CheckedAllocator)(nil)
+)
@@ -0,0 +1,74 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomemtest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/gomemtest"
+)
+
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Helper() {}
+
+func leakInt64s(pool memory.Allocator) *array.Int64 {
+	b := array.NewInt64Builder(pool)
+	defer b.Release()
+	b.AppendValues([]int64{1, 2, 3}, nil)
+	return b.NewInt64Array()
+}
+
+func TestCheckedAllocator(t *testing.T) {
+	pool := gomemtest.NewCheckedAllocator(memory.NewGoAllocator())
+
+	arr := leakInt64s(pool)
+	if got := pool.CurrentAlloc(); got == 0 {
+		t.Fatalf("got=%d, want > 0", got)
+	}
+
+	var r recorder
+	pool.AssertSize(&r, 0)
+	if got, want := len(r.errors), 1; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if got, want := r.errors[0], "gomemtest_test.leakInt64s"; !strings.Contains(got, want) {
+		t.Fatalf("got=\n%v\nwant it to contain %q", got, want)
+	}
+
+	arr.Release()
+	r.errors = nil
+	pool.AssertSize(&r, 0)
+	if got, want := len(r.errors), 0; got != want {
+		t.Fatalf("got=%d, want=%d: %v", got, want, r.errors)
+	}
+}
+
+func TestNewAllocator(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	arr := leakInt64s(pool)
+	defer arr.Release()
+}
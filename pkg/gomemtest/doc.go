@@ -0,0 +1,39 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package gomemtest provides helpers for testing code that uses gomem,
+such as an allocator that reports where leaked memory was allocated.
+
+	func TestSomething(t *testing.T) {
+		pool := gomemtest.NewAllocator(t)
+
+		df, err := dataframe.NewDataFrameFromMem(pool, dataframe.Dict{"a": []int32{1, 2}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer df.Release()
+		...
+	}
+
+When the test finishes with memory still allocated, it fails with the stacks the outstanding
+allocations came from. Build with the debug tag to also list the DataFrames and chunk iterators
+built from that allocator's memory that still have references, along with the call sites that
+created, retained and released them. Each allocator only reports its own objects, so parallel
+tests don't see each other's leaks.
+
+The package is named gomemtest rather than testing so that tests can import it next to the
+standard library's testing package, in the same way as net/http/httptest.
+*/
+package gomemtest
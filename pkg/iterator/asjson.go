@@ -123,6 +123,11 @@ func stringAsJSON(v interface{}) (interface{}, error) {
 	return v, nil
 }
 
+func binaryAsJSON(v interface{}) (interface{}, error) {
+	// encoding/json encodes []byte as a base64 string.
+	return v, nil
+}
+
 // func fixedSizeBinary(v interface{}) (interface{}, error) {
 // 	// TODO(nickpoorman): Verify this is correct....
 // 	// dt := dtype.(*arrow.FixedSizeBinaryType)
@@ -0,0 +1,41 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// validity decodes the validity of the values in ref from start up to end into buf,
+// growing it when needed, and returns it.
+func validity(buf []bool, ref array.Interface, start, end int) []bool {
+	n := end - start
+	if cap(buf) < n {
+		buf = make([]bool, n)
+	}
+	buf = buf[:n]
+
+	if ref.NullN() == 0 {
+		for i := range buf {
+			buf[i] = true
+		}
+		return buf
+	}
+
+	for i := range buf {
+		buf[i] = ref.IsValid(start + i)
+	}
+	return buf
+}
@@ -17,6 +17,7 @@
 package iterator
 
 import (
+	"sort"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
@@ -37,6 +38,9 @@ type Date32ChunkIterator struct {
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
 	currentIndex int           // current chunk
 	currentChunk *array.Date32 // current chunk
@@ -49,6 +53,7 @@ func NewDate32ChunkIterator(col *array.Column) *Date32ChunkIterator {
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
 	chunks := make([]*array.Date32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
@@ -59,11 +64,13 @@ func NewDate32ChunkIterator(col *array.Column) *Date32ChunkIterator {
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Date32ChunkIterator{
+	cr := &Date32ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -72,9 +79,14 @@ func NewDate32ChunkIterator(col *array.Column) *Date32ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
@@ -107,15 +119,58 @@ func (cr *Date32ChunkIterator) Next() bool {
 	return true
 }
 
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Date32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Date32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Date32ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Date32ChunkIterator) Len() int64 { return cr.length }
+
 // Retain keeps a reference to the Date32ChunkIterator
 func (cr *Date32ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
 }
 
 // Release removes a reference to the Date32ChunkIterator
 func (cr *Date32ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -131,44 +186,50 @@ func (cr *Date32ChunkIterator) Release() {
 	}
 }
 
-// Date64ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Date64ChunkIterator struct {
+// ReverseDate32ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseDate32ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Date64 // cache the chunks on this iterator
+	chunks []*array.Date32 // cache the chunks on this iterator
 	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int           // current chunk
-	currentChunk *array.Date64 // current chunk
+	currentIndex int           // next chunk, counting down
+	currentChunk *array.Date32 // current chunk
 }
 
-// NewDate64ChunkIterator creates a new Date64ChunkIterator for reading an Arrow Column.
-func NewDate64ChunkIterator(col *array.Column) *Date64ChunkIterator {
+// NewReverseDate32ChunkIterator creates a new ReverseDate32ChunkIterator for reading an Arrow Column.
+func NewReverseDate32ChunkIterator(col *array.Column) *ReverseDate32ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Date64, len(columnChunks))
+	chunks := make([]*array.Date32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Date64)
+		chunks[i] = chunk.(*array.Date32)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Date64ChunkIterator{
+	cr := &ReverseDate32ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -177,27 +238,32 @@ func NewDate64ChunkIterator(col *array.Column) *Date64ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Date64ChunkIterator) Chunk() *array.Date64 { return cr.currentChunk }
+func (cr *ReverseDate32ChunkIterator) Chunk() *array.Date32 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []arrow.Date64 chunk values.
-// Keep in mind the []arrow.Date64 type might not be able
+// ChunkValues returns the underlying []arrow.Date32 chunk values.
+// Keep in mind the []arrow.Date32 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Date64ChunkIterator) ChunkValues() []arrow.Date64 {
+func (cr *ReverseDate32ChunkIterator) ChunkValues() []arrow.Date32 {
 
-	return cr.Chunk().Date64Values()
+	return cr.Chunk().Date32Values()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Date64ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseDate32ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -207,20 +273,63 @@ func (cr *Date64ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Date64ChunkIterator
-func (cr *Date64ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseDate32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Date64ChunkIterator
-func (cr *Date64ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseDate32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseDate32ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseDate32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseDate32ChunkIterator
+func (cr *ReverseDate32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseDate32ChunkIterator
+func (cr *ReverseDate32ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -236,44 +345,50 @@ func (cr *Date64ChunkIterator) Release() {
 	}
 }
 
-// DayTimeIntervalChunkIterator is an iterator for reading an Arrow Column value by value.
-type DayTimeIntervalChunkIterator struct {
+// Date64ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Date64ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.DayTimeInterval // cache the chunks on this iterator
-	length int64                    // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Date64 // cache the chunks on this iterator
+	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int                    // current chunk
-	currentChunk *array.DayTimeInterval // current chunk
+	currentIndex int           // current chunk
+	currentChunk *array.Date64 // current chunk
 }
 
-// NewDayTimeIntervalChunkIterator creates a new DayTimeIntervalChunkIterator for reading an Arrow Column.
-func NewDayTimeIntervalChunkIterator(col *array.Column) *DayTimeIntervalChunkIterator {
+// NewDate64ChunkIterator creates a new Date64ChunkIterator for reading an Arrow Column.
+func NewDate64ChunkIterator(col *array.Column) *Date64ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.DayTimeInterval, len(columnChunks))
+	chunks := make([]*array.Date64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.DayTimeInterval)
+		chunks[i] = chunk.(*array.Date64)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &DayTimeIntervalChunkIterator{
+	cr := &Date64ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -282,26 +397,31 @@ func NewDayTimeIntervalChunkIterator(col *array.Column) *DayTimeIntervalChunkIte
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *DayTimeIntervalChunkIterator) Chunk() *array.DayTimeInterval { return cr.currentChunk }
+func (cr *Date64ChunkIterator) Chunk() *array.Date64 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []arrow.DayTimeInterval chunk values.
-// Keep in mind the []arrow.DayTimeInterval type might not be able
+// ChunkValues returns the underlying []arrow.Date64 chunk values.
+// Keep in mind the []arrow.Date64 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *DayTimeIntervalChunkIterator) ChunkValues() []arrow.DayTimeInterval {
+func (cr *Date64ChunkIterator) ChunkValues() []arrow.Date64 {
 
-	return cr.Chunk().DayTimeIntervalValues()
+	return cr.Chunk().Date64Values()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *DayTimeIntervalChunkIterator) Next() bool {
+func (cr *Date64ChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -317,15 +437,58 @@ func (cr *DayTimeIntervalChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the DayTimeIntervalChunkIterator
-func (cr *DayTimeIntervalChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Date64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the DayTimeIntervalChunkIterator
-func (cr *DayTimeIntervalChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Date64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Date64ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Date64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Date64ChunkIterator
+func (cr *Date64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Date64ChunkIterator
+func (cr *Date64ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -341,44 +504,50 @@ func (cr *DayTimeIntervalChunkIterator) Release() {
 	}
 }
 
-// Decimal128ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Decimal128ChunkIterator struct {
+// ReverseDate64ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseDate64ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Decimal128 // cache the chunks on this iterator
-	length int64               // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Date64 // cache the chunks on this iterator
+	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int               // current chunk
-	currentChunk *array.Decimal128 // current chunk
+	currentIndex int           // next chunk, counting down
+	currentChunk *array.Date64 // current chunk
 }
 
-// NewDecimal128ChunkIterator creates a new Decimal128ChunkIterator for reading an Arrow Column.
-func NewDecimal128ChunkIterator(col *array.Column) *Decimal128ChunkIterator {
+// NewReverseDate64ChunkIterator creates a new ReverseDate64ChunkIterator for reading an Arrow Column.
+func NewReverseDate64ChunkIterator(col *array.Column) *ReverseDate64ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Decimal128, len(columnChunks))
+	chunks := make([]*array.Date64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Decimal128)
+		chunks[i] = chunk.(*array.Date64)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Decimal128ChunkIterator{
+	cr := &ReverseDate64ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -387,27 +556,32 @@ func NewDecimal128ChunkIterator(col *array.Column) *Decimal128ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Decimal128ChunkIterator) Chunk() *array.Decimal128 { return cr.currentChunk }
+func (cr *ReverseDate64ChunkIterator) Chunk() *array.Date64 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []decimal128.Num chunk values.
-// Keep in mind the []decimal128.Num type might not be able
+// ChunkValues returns the underlying []arrow.Date64 chunk values.
+// Keep in mind the []arrow.Date64 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Decimal128ChunkIterator) ChunkValues() []decimal128.Num {
+func (cr *ReverseDate64ChunkIterator) ChunkValues() []arrow.Date64 {
 
-	return cr.Chunk().Values()
+	return cr.Chunk().Date64Values()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Decimal128ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseDate64ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -417,20 +591,63 @@ func (cr *Decimal128ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Decimal128ChunkIterator
-func (cr *Decimal128ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseDate64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Decimal128ChunkIterator
-func (cr *Decimal128ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseDate64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseDate64ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseDate64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseDate64ChunkIterator
+func (cr *ReverseDate64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseDate64ChunkIterator
+func (cr *ReverseDate64ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -446,44 +663,50 @@ func (cr *Decimal128ChunkIterator) Release() {
 	}
 }
 
-// DurationChunkIterator is an iterator for reading an Arrow Column value by value.
-type DurationChunkIterator struct {
+// DayTimeIntervalChunkIterator is an iterator for reading an Arrow Column value by value.
+type DayTimeIntervalChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Duration // cache the chunks on this iterator
-	length int64             // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.DayTimeInterval // cache the chunks on this iterator
+	length int64                    // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int             // current chunk
-	currentChunk *array.Duration // current chunk
+	currentIndex int                    // current chunk
+	currentChunk *array.DayTimeInterval // current chunk
 }
 
-// NewDurationChunkIterator creates a new DurationChunkIterator for reading an Arrow Column.
-func NewDurationChunkIterator(col *array.Column) *DurationChunkIterator {
+// NewDayTimeIntervalChunkIterator creates a new DayTimeIntervalChunkIterator for reading an Arrow Column.
+func NewDayTimeIntervalChunkIterator(col *array.Column) *DayTimeIntervalChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Duration, len(columnChunks))
+	chunks := make([]*array.DayTimeInterval, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Duration)
+		chunks[i] = chunk.(*array.DayTimeInterval)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &DurationChunkIterator{
+	cr := &DayTimeIntervalChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -492,26 +715,31 @@ func NewDurationChunkIterator(col *array.Column) *DurationChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *DurationChunkIterator) Chunk() *array.Duration { return cr.currentChunk }
+func (cr *DayTimeIntervalChunkIterator) Chunk() *array.DayTimeInterval { return cr.currentChunk }
 
-// ChunkValues returns the underlying []arrow.Duration chunk values.
-// Keep in mind the []arrow.Duration type might not be able
+// ChunkValues returns the underlying []arrow.DayTimeInterval chunk values.
+// Keep in mind the []arrow.DayTimeInterval type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *DurationChunkIterator) ChunkValues() []arrow.Duration {
+func (cr *DayTimeIntervalChunkIterator) ChunkValues() []arrow.DayTimeInterval {
 
-	return cr.Chunk().DurationValues()
+	return cr.Chunk().DayTimeIntervalValues()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *DurationChunkIterator) Next() bool {
+func (cr *DayTimeIntervalChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -527,15 +755,58 @@ func (cr *DurationChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the DurationChunkIterator
-func (cr *DurationChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *DayTimeIntervalChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the DurationChunkIterator
-func (cr *DurationChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *DayTimeIntervalChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *DayTimeIntervalChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *DayTimeIntervalChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the DayTimeIntervalChunkIterator
+func (cr *DayTimeIntervalChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the DayTimeIntervalChunkIterator
+func (cr *DayTimeIntervalChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -551,44 +822,50 @@ func (cr *DurationChunkIterator) Release() {
 	}
 }
 
-// Float16ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Float16ChunkIterator struct {
+// ReverseDayTimeIntervalChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseDayTimeIntervalChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Float16 // cache the chunks on this iterator
-	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.DayTimeInterval // cache the chunks on this iterator
+	length int64                    // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int            // current chunk
-	currentChunk *array.Float16 // current chunk
+	currentIndex int                    // next chunk, counting down
+	currentChunk *array.DayTimeInterval // current chunk
 }
 
-// NewFloat16ChunkIterator creates a new Float16ChunkIterator for reading an Arrow Column.
-func NewFloat16ChunkIterator(col *array.Column) *Float16ChunkIterator {
+// NewReverseDayTimeIntervalChunkIterator creates a new ReverseDayTimeIntervalChunkIterator for reading an Arrow Column.
+func NewReverseDayTimeIntervalChunkIterator(col *array.Column) *ReverseDayTimeIntervalChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Float16, len(columnChunks))
+	chunks := make([]*array.DayTimeInterval, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Float16)
+		chunks[i] = chunk.(*array.DayTimeInterval)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Float16ChunkIterator{
+	cr := &ReverseDayTimeIntervalChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -597,27 +874,32 @@ func NewFloat16ChunkIterator(col *array.Column) *Float16ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Float16ChunkIterator) Chunk() *array.Float16 { return cr.currentChunk }
+func (cr *ReverseDayTimeIntervalChunkIterator) Chunk() *array.DayTimeInterval { return cr.currentChunk }
 
-// ChunkValues returns the underlying []float16.Num chunk values.
-// Keep in mind the []float16.Num type might not be able
+// ChunkValues returns the underlying []arrow.DayTimeInterval chunk values.
+// Keep in mind the []arrow.DayTimeInterval type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Float16ChunkIterator) ChunkValues() []float16.Num {
+func (cr *ReverseDayTimeIntervalChunkIterator) ChunkValues() []arrow.DayTimeInterval {
 
-	return cr.Chunk().Values()
+	return cr.Chunk().DayTimeIntervalValues()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Float16ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseDayTimeIntervalChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -627,20 +909,63 @@ func (cr *Float16ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Float16ChunkIterator
-func (cr *Float16ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseDayTimeIntervalChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Float16ChunkIterator
-func (cr *Float16ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseDayTimeIntervalChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseDayTimeIntervalChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseDayTimeIntervalChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseDayTimeIntervalChunkIterator
+func (cr *ReverseDayTimeIntervalChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseDayTimeIntervalChunkIterator
+func (cr *ReverseDayTimeIntervalChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -656,44 +981,50 @@ func (cr *Float16ChunkIterator) Release() {
 	}
 }
 
-// Float32ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Float32ChunkIterator struct {
+// Decimal128ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Decimal128ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Float32 // cache the chunks on this iterator
-	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Decimal128 // cache the chunks on this iterator
+	length int64               // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int            // current chunk
-	currentChunk *array.Float32 // current chunk
+	currentIndex int               // current chunk
+	currentChunk *array.Decimal128 // current chunk
 }
 
-// NewFloat32ChunkIterator creates a new Float32ChunkIterator for reading an Arrow Column.
-func NewFloat32ChunkIterator(col *array.Column) *Float32ChunkIterator {
+// NewDecimal128ChunkIterator creates a new Decimal128ChunkIterator for reading an Arrow Column.
+func NewDecimal128ChunkIterator(col *array.Column) *Decimal128ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Float32, len(columnChunks))
+	chunks := make([]*array.Decimal128, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Float32)
+		chunks[i] = chunk.(*array.Decimal128)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Float32ChunkIterator{
+	cr := &Decimal128ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -702,26 +1033,31 @@ func NewFloat32ChunkIterator(col *array.Column) *Float32ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Float32ChunkIterator) Chunk() *array.Float32 { return cr.currentChunk }
+func (cr *Decimal128ChunkIterator) Chunk() *array.Decimal128 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []float32 chunk values.
-// Keep in mind the []float32 type might not be able
+// ChunkValues returns the underlying []decimal128.Num chunk values.
+// Keep in mind the []decimal128.Num type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Float32ChunkIterator) ChunkValues() []float32 {
+func (cr *Decimal128ChunkIterator) ChunkValues() []decimal128.Num {
 
-	return cr.Chunk().Float32Values()
+	return cr.Chunk().Values()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *Float32ChunkIterator) Next() bool {
+func (cr *Decimal128ChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -737,15 +1073,58 @@ func (cr *Float32ChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the Float32ChunkIterator
-func (cr *Float32ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Decimal128ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Float32ChunkIterator
-func (cr *Float32ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Decimal128ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Decimal128ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Decimal128ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Decimal128ChunkIterator
+func (cr *Decimal128ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Decimal128ChunkIterator
+func (cr *Decimal128ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -761,44 +1140,50 @@ func (cr *Float32ChunkIterator) Release() {
 	}
 }
 
-// Float64ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Float64ChunkIterator struct {
+// ReverseDecimal128ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseDecimal128ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Float64 // cache the chunks on this iterator
-	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Decimal128 // cache the chunks on this iterator
+	length int64               // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int            // current chunk
-	currentChunk *array.Float64 // current chunk
+	currentIndex int               // next chunk, counting down
+	currentChunk *array.Decimal128 // current chunk
 }
 
-// NewFloat64ChunkIterator creates a new Float64ChunkIterator for reading an Arrow Column.
-func NewFloat64ChunkIterator(col *array.Column) *Float64ChunkIterator {
+// NewReverseDecimal128ChunkIterator creates a new ReverseDecimal128ChunkIterator for reading an Arrow Column.
+func NewReverseDecimal128ChunkIterator(col *array.Column) *ReverseDecimal128ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Float64, len(columnChunks))
+	chunks := make([]*array.Decimal128, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Float64)
+		chunks[i] = chunk.(*array.Decimal128)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Float64ChunkIterator{
+	cr := &ReverseDecimal128ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -807,27 +1192,32 @@ func NewFloat64ChunkIterator(col *array.Column) *Float64ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Float64ChunkIterator) Chunk() *array.Float64 { return cr.currentChunk }
+func (cr *ReverseDecimal128ChunkIterator) Chunk() *array.Decimal128 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []float64 chunk values.
-// Keep in mind the []float64 type might not be able
+// ChunkValues returns the underlying []decimal128.Num chunk values.
+// Keep in mind the []decimal128.Num type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Float64ChunkIterator) ChunkValues() []float64 {
+func (cr *ReverseDecimal128ChunkIterator) ChunkValues() []decimal128.Num {
 
-	return cr.Chunk().Float64Values()
+	return cr.Chunk().Values()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Float64ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseDecimal128ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -837,20 +1227,63 @@ func (cr *Float64ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Float64ChunkIterator
-func (cr *Float64ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseDecimal128ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Float64ChunkIterator
-func (cr *Float64ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseDecimal128ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseDecimal128ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseDecimal128ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseDecimal128ChunkIterator
+func (cr *ReverseDecimal128ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseDecimal128ChunkIterator
+func (cr *ReverseDecimal128ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -866,44 +1299,50 @@ func (cr *Float64ChunkIterator) Release() {
 	}
 }
 
-// Int16ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Int16ChunkIterator struct {
+// DurationChunkIterator is an iterator for reading an Arrow Column value by value.
+type DurationChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Int16 // cache the chunks on this iterator
-	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Duration // cache the chunks on this iterator
+	length int64             // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int          // current chunk
-	currentChunk *array.Int16 // current chunk
+	currentIndex int             // current chunk
+	currentChunk *array.Duration // current chunk
 }
 
-// NewInt16ChunkIterator creates a new Int16ChunkIterator for reading an Arrow Column.
-func NewInt16ChunkIterator(col *array.Column) *Int16ChunkIterator {
+// NewDurationChunkIterator creates a new DurationChunkIterator for reading an Arrow Column.
+func NewDurationChunkIterator(col *array.Column) *DurationChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Int16, len(columnChunks))
+	chunks := make([]*array.Duration, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Int16)
+		chunks[i] = chunk.(*array.Duration)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Int16ChunkIterator{
+	cr := &DurationChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -912,26 +1351,31 @@ func NewInt16ChunkIterator(col *array.Column) *Int16ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Int16ChunkIterator) Chunk() *array.Int16 { return cr.currentChunk }
+func (cr *DurationChunkIterator) Chunk() *array.Duration { return cr.currentChunk }
 
-// ChunkValues returns the underlying []int16 chunk values.
-// Keep in mind the []int16 type might not be able
+// ChunkValues returns the underlying []arrow.Duration chunk values.
+// Keep in mind the []arrow.Duration type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Int16ChunkIterator) ChunkValues() []int16 {
+func (cr *DurationChunkIterator) ChunkValues() []arrow.Duration {
 
-	return cr.Chunk().Int16Values()
+	return cr.Chunk().DurationValues()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *Int16ChunkIterator) Next() bool {
+func (cr *DurationChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -947,15 +1391,58 @@ func (cr *Int16ChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the Int16ChunkIterator
-func (cr *Int16ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *DurationChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Int16ChunkIterator
-func (cr *Int16ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *DurationChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *DurationChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *DurationChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the DurationChunkIterator
+func (cr *DurationChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the DurationChunkIterator
+func (cr *DurationChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -971,44 +1458,50 @@ func (cr *Int16ChunkIterator) Release() {
 	}
 }
 
-// Int32ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Int32ChunkIterator struct {
+// ReverseDurationChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseDurationChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Int32 // cache the chunks on this iterator
-	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Duration // cache the chunks on this iterator
+	length int64             // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int          // current chunk
-	currentChunk *array.Int32 // current chunk
+	currentIndex int             // next chunk, counting down
+	currentChunk *array.Duration // current chunk
 }
 
-// NewInt32ChunkIterator creates a new Int32ChunkIterator for reading an Arrow Column.
-func NewInt32ChunkIterator(col *array.Column) *Int32ChunkIterator {
+// NewReverseDurationChunkIterator creates a new ReverseDurationChunkIterator for reading an Arrow Column.
+func NewReverseDurationChunkIterator(col *array.Column) *ReverseDurationChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Int32, len(columnChunks))
+	chunks := make([]*array.Duration, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Int32)
+		chunks[i] = chunk.(*array.Duration)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Int32ChunkIterator{
+	cr := &ReverseDurationChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1017,27 +1510,32 @@ func NewInt32ChunkIterator(col *array.Column) *Int32ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Int32ChunkIterator) Chunk() *array.Int32 { return cr.currentChunk }
+func (cr *ReverseDurationChunkIterator) Chunk() *array.Duration { return cr.currentChunk }
 
-// ChunkValues returns the underlying []int32 chunk values.
-// Keep in mind the []int32 type might not be able
+// ChunkValues returns the underlying []arrow.Duration chunk values.
+// Keep in mind the []arrow.Duration type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Int32ChunkIterator) ChunkValues() []int32 {
+func (cr *ReverseDurationChunkIterator) ChunkValues() []arrow.Duration {
 
-	return cr.Chunk().Int32Values()
+	return cr.Chunk().DurationValues()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Int32ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseDurationChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -1047,20 +1545,3084 @@ func (cr *Int32ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Int32ChunkIterator
-func (cr *Int32ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseDurationChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Int32ChunkIterator
-func (cr *Int32ChunkIterator) Release() {
-	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseDurationChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseDurationChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseDurationChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseDurationChunkIterator
+func (cr *ReverseDurationChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseDurationChunkIterator
+func (cr *ReverseDurationChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Float16ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Float16ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Float16 // cache the chunks on this iterator
+	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int            // current chunk
+	currentChunk *array.Float16 // current chunk
+}
+
+// NewFloat16ChunkIterator creates a new Float16ChunkIterator for reading an Arrow Column.
+func NewFloat16ChunkIterator(col *array.Column) *Float16ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Float16, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Float16)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Float16ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Float16ChunkIterator) Chunk() *array.Float16 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []float16.Num chunk values.
+// Keep in mind the []float16.Num type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Float16ChunkIterator) ChunkValues() []float16.Num {
+
+	return cr.Chunk().Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Float16ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Float16ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Float16ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Float16ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Float16ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Float16ChunkIterator
+func (cr *Float16ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Float16ChunkIterator
+func (cr *Float16ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseFloat16ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseFloat16ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Float16 // cache the chunks on this iterator
+	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int            // next chunk, counting down
+	currentChunk *array.Float16 // current chunk
+}
+
+// NewReverseFloat16ChunkIterator creates a new ReverseFloat16ChunkIterator for reading an Arrow Column.
+func NewReverseFloat16ChunkIterator(col *array.Column) *ReverseFloat16ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Float16, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Float16)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseFloat16ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseFloat16ChunkIterator) Chunk() *array.Float16 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []float16.Num chunk values.
+// Keep in mind the []float16.Num type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseFloat16ChunkIterator) ChunkValues() []float16.Num {
+
+	return cr.Chunk().Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseFloat16ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseFloat16ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseFloat16ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseFloat16ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseFloat16ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseFloat16ChunkIterator
+func (cr *ReverseFloat16ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseFloat16ChunkIterator
+func (cr *ReverseFloat16ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Float32ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Float32ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Float32 // cache the chunks on this iterator
+	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int            // current chunk
+	currentChunk *array.Float32 // current chunk
+}
+
+// NewFloat32ChunkIterator creates a new Float32ChunkIterator for reading an Arrow Column.
+func NewFloat32ChunkIterator(col *array.Column) *Float32ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Float32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Float32)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Float32ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Float32ChunkIterator) Chunk() *array.Float32 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []float32 chunk values.
+// Keep in mind the []float32 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Float32ChunkIterator) ChunkValues() []float32 {
+
+	return cr.Chunk().Float32Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Float32ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Float32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Float32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Float32ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Float32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Float32ChunkIterator
+func (cr *Float32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Float32ChunkIterator
+func (cr *Float32ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseFloat32ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseFloat32ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Float32 // cache the chunks on this iterator
+	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int            // next chunk, counting down
+	currentChunk *array.Float32 // current chunk
+}
+
+// NewReverseFloat32ChunkIterator creates a new ReverseFloat32ChunkIterator for reading an Arrow Column.
+func NewReverseFloat32ChunkIterator(col *array.Column) *ReverseFloat32ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Float32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Float32)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseFloat32ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseFloat32ChunkIterator) Chunk() *array.Float32 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []float32 chunk values.
+// Keep in mind the []float32 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseFloat32ChunkIterator) ChunkValues() []float32 {
+
+	return cr.Chunk().Float32Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseFloat32ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseFloat32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseFloat32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseFloat32ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseFloat32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseFloat32ChunkIterator
+func (cr *ReverseFloat32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseFloat32ChunkIterator
+func (cr *ReverseFloat32ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Float64ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Float64ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Float64 // cache the chunks on this iterator
+	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int            // current chunk
+	currentChunk *array.Float64 // current chunk
+}
+
+// NewFloat64ChunkIterator creates a new Float64ChunkIterator for reading an Arrow Column.
+func NewFloat64ChunkIterator(col *array.Column) *Float64ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Float64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Float64)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Float64ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Float64ChunkIterator) Chunk() *array.Float64 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []float64 chunk values.
+// Keep in mind the []float64 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Float64ChunkIterator) ChunkValues() []float64 {
+
+	return cr.Chunk().Float64Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Float64ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Float64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Float64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Float64ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Float64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Float64ChunkIterator
+func (cr *Float64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Float64ChunkIterator
+func (cr *Float64ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseFloat64ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseFloat64ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Float64 // cache the chunks on this iterator
+	length int64            // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int            // next chunk, counting down
+	currentChunk *array.Float64 // current chunk
+}
+
+// NewReverseFloat64ChunkIterator creates a new ReverseFloat64ChunkIterator for reading an Arrow Column.
+func NewReverseFloat64ChunkIterator(col *array.Column) *ReverseFloat64ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Float64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Float64)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseFloat64ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseFloat64ChunkIterator) Chunk() *array.Float64 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []float64 chunk values.
+// Keep in mind the []float64 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseFloat64ChunkIterator) ChunkValues() []float64 {
+
+	return cr.Chunk().Float64Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseFloat64ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseFloat64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseFloat64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseFloat64ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseFloat64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseFloat64ChunkIterator
+func (cr *ReverseFloat64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseFloat64ChunkIterator
+func (cr *ReverseFloat64ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Int16ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Int16ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Int16 // cache the chunks on this iterator
+	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int          // current chunk
+	currentChunk *array.Int16 // current chunk
+}
+
+// NewInt16ChunkIterator creates a new Int16ChunkIterator for reading an Arrow Column.
+func NewInt16ChunkIterator(col *array.Column) *Int16ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Int16, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Int16)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Int16ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Int16ChunkIterator) Chunk() *array.Int16 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []int16 chunk values.
+// Keep in mind the []int16 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Int16ChunkIterator) ChunkValues() []int16 {
+
+	return cr.Chunk().Int16Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Int16ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Int16ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Int16ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Int16ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Int16ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Int16ChunkIterator
+func (cr *Int16ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Int16ChunkIterator
+func (cr *Int16ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseInt16ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseInt16ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Int16 // cache the chunks on this iterator
+	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int          // next chunk, counting down
+	currentChunk *array.Int16 // current chunk
+}
+
+// NewReverseInt16ChunkIterator creates a new ReverseInt16ChunkIterator for reading an Arrow Column.
+func NewReverseInt16ChunkIterator(col *array.Column) *ReverseInt16ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Int16, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Int16)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseInt16ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseInt16ChunkIterator) Chunk() *array.Int16 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []int16 chunk values.
+// Keep in mind the []int16 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseInt16ChunkIterator) ChunkValues() []int16 {
+
+	return cr.Chunk().Int16Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseInt16ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseInt16ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseInt16ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseInt16ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseInt16ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseInt16ChunkIterator
+func (cr *ReverseInt16ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseInt16ChunkIterator
+func (cr *ReverseInt16ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Int32ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Int32ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Int32 // cache the chunks on this iterator
+	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int          // current chunk
+	currentChunk *array.Int32 // current chunk
+}
+
+// NewInt32ChunkIterator creates a new Int32ChunkIterator for reading an Arrow Column.
+func NewInt32ChunkIterator(col *array.Column) *Int32ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Int32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Int32)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Int32ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Int32ChunkIterator) Chunk() *array.Int32 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []int32 chunk values.
+// Keep in mind the []int32 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Int32ChunkIterator) ChunkValues() []int32 {
+
+	return cr.Chunk().Int32Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Int32ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Int32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Int32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Int32ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Int32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Int32ChunkIterator
+func (cr *Int32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Int32ChunkIterator
+func (cr *Int32ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseInt32ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseInt32ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Int32 // cache the chunks on this iterator
+	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int          // next chunk, counting down
+	currentChunk *array.Int32 // current chunk
+}
+
+// NewReverseInt32ChunkIterator creates a new ReverseInt32ChunkIterator for reading an Arrow Column.
+func NewReverseInt32ChunkIterator(col *array.Column) *ReverseInt32ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Int32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Int32)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseInt32ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseInt32ChunkIterator) Chunk() *array.Int32 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []int32 chunk values.
+// Keep in mind the []int32 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseInt32ChunkIterator) ChunkValues() []int32 {
+
+	return cr.Chunk().Int32Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseInt32ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseInt32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseInt32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseInt32ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseInt32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseInt32ChunkIterator
+func (cr *ReverseInt32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseInt32ChunkIterator
+func (cr *ReverseInt32ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Int64ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Int64ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Int64 // cache the chunks on this iterator
+	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int          // current chunk
+	currentChunk *array.Int64 // current chunk
+}
+
+// NewInt64ChunkIterator creates a new Int64ChunkIterator for reading an Arrow Column.
+func NewInt64ChunkIterator(col *array.Column) *Int64ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Int64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Int64)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Int64ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Int64ChunkIterator) Chunk() *array.Int64 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []int64 chunk values.
+// Keep in mind the []int64 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Int64ChunkIterator) ChunkValues() []int64 {
+
+	return cr.Chunk().Int64Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Int64ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Int64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Int64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Int64ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Int64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Int64ChunkIterator
+func (cr *Int64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Int64ChunkIterator
+func (cr *Int64ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseInt64ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseInt64ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Int64 // cache the chunks on this iterator
+	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int          // next chunk, counting down
+	currentChunk *array.Int64 // current chunk
+}
+
+// NewReverseInt64ChunkIterator creates a new ReverseInt64ChunkIterator for reading an Arrow Column.
+func NewReverseInt64ChunkIterator(col *array.Column) *ReverseInt64ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Int64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Int64)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseInt64ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseInt64ChunkIterator) Chunk() *array.Int64 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []int64 chunk values.
+// Keep in mind the []int64 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseInt64ChunkIterator) ChunkValues() []int64 {
+
+	return cr.Chunk().Int64Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseInt64ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseInt64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseInt64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseInt64ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseInt64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseInt64ChunkIterator
+func (cr *ReverseInt64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseInt64ChunkIterator
+func (cr *ReverseInt64ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Int8ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Int8ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Int8 // cache the chunks on this iterator
+	length int64         // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int         // current chunk
+	currentChunk *array.Int8 // current chunk
+}
+
+// NewInt8ChunkIterator creates a new Int8ChunkIterator for reading an Arrow Column.
+func NewInt8ChunkIterator(col *array.Column) *Int8ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Int8, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Int8)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Int8ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Int8ChunkIterator) Chunk() *array.Int8 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []int8 chunk values.
+// Keep in mind the []int8 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Int8ChunkIterator) ChunkValues() []int8 {
+
+	return cr.Chunk().Int8Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Int8ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Int8ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Int8ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Int8ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Int8ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Int8ChunkIterator
+func (cr *Int8ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Int8ChunkIterator
+func (cr *Int8ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseInt8ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseInt8ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Int8 // cache the chunks on this iterator
+	length int64         // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int         // next chunk, counting down
+	currentChunk *array.Int8 // current chunk
+}
+
+// NewReverseInt8ChunkIterator creates a new ReverseInt8ChunkIterator for reading an Arrow Column.
+func NewReverseInt8ChunkIterator(col *array.Column) *ReverseInt8ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Int8, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Int8)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseInt8ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseInt8ChunkIterator) Chunk() *array.Int8 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []int8 chunk values.
+// Keep in mind the []int8 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseInt8ChunkIterator) ChunkValues() []int8 {
+
+	return cr.Chunk().Int8Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseInt8ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseInt8ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseInt8ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseInt8ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseInt8ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseInt8ChunkIterator
+func (cr *ReverseInt8ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseInt8ChunkIterator
+func (cr *ReverseInt8ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// MonthIntervalChunkIterator is an iterator for reading an Arrow Column value by value.
+type MonthIntervalChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.MonthInterval // cache the chunks on this iterator
+	length int64                  // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int                  // current chunk
+	currentChunk *array.MonthInterval // current chunk
+}
+
+// NewMonthIntervalChunkIterator creates a new MonthIntervalChunkIterator for reading an Arrow Column.
+func NewMonthIntervalChunkIterator(col *array.Column) *MonthIntervalChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.MonthInterval, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.MonthInterval)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &MonthIntervalChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *MonthIntervalChunkIterator) Chunk() *array.MonthInterval { return cr.currentChunk }
+
+// ChunkValues returns the underlying []arrow.MonthInterval chunk values.
+// Keep in mind the []arrow.MonthInterval type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *MonthIntervalChunkIterator) ChunkValues() []arrow.MonthInterval {
+
+	return cr.Chunk().MonthIntervalValues()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *MonthIntervalChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *MonthIntervalChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *MonthIntervalChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *MonthIntervalChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *MonthIntervalChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the MonthIntervalChunkIterator
+func (cr *MonthIntervalChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the MonthIntervalChunkIterator
+func (cr *MonthIntervalChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseMonthIntervalChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseMonthIntervalChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.MonthInterval // cache the chunks on this iterator
+	length int64                  // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int                  // next chunk, counting down
+	currentChunk *array.MonthInterval // current chunk
+}
+
+// NewReverseMonthIntervalChunkIterator creates a new ReverseMonthIntervalChunkIterator for reading an Arrow Column.
+func NewReverseMonthIntervalChunkIterator(col *array.Column) *ReverseMonthIntervalChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.MonthInterval, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.MonthInterval)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseMonthIntervalChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseMonthIntervalChunkIterator) Chunk() *array.MonthInterval { return cr.currentChunk }
+
+// ChunkValues returns the underlying []arrow.MonthInterval chunk values.
+// Keep in mind the []arrow.MonthInterval type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseMonthIntervalChunkIterator) ChunkValues() []arrow.MonthInterval {
+
+	return cr.Chunk().MonthIntervalValues()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseMonthIntervalChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseMonthIntervalChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseMonthIntervalChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseMonthIntervalChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseMonthIntervalChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseMonthIntervalChunkIterator
+func (cr *ReverseMonthIntervalChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseMonthIntervalChunkIterator
+func (cr *ReverseMonthIntervalChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Time32ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Time32ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Time32 // cache the chunks on this iterator
+	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int           // current chunk
+	currentChunk *array.Time32 // current chunk
+}
+
+// NewTime32ChunkIterator creates a new Time32ChunkIterator for reading an Arrow Column.
+func NewTime32ChunkIterator(col *array.Column) *Time32ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Time32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Time32)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Time32ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Time32ChunkIterator) Chunk() *array.Time32 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []arrow.Time32 chunk values.
+// Keep in mind the []arrow.Time32 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Time32ChunkIterator) ChunkValues() []arrow.Time32 {
+
+	return cr.Chunk().Time32Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Time32ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Time32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Time32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Time32ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Time32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Time32ChunkIterator
+func (cr *Time32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Time32ChunkIterator
+func (cr *Time32ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseTime32ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseTime32ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Time32 // cache the chunks on this iterator
+	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int           // next chunk, counting down
+	currentChunk *array.Time32 // current chunk
+}
+
+// NewReverseTime32ChunkIterator creates a new ReverseTime32ChunkIterator for reading an Arrow Column.
+func NewReverseTime32ChunkIterator(col *array.Column) *ReverseTime32ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Time32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Time32)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseTime32ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseTime32ChunkIterator) Chunk() *array.Time32 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []arrow.Time32 chunk values.
+// Keep in mind the []arrow.Time32 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseTime32ChunkIterator) ChunkValues() []arrow.Time32 {
+
+	return cr.Chunk().Time32Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseTime32ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseTime32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseTime32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseTime32ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseTime32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseTime32ChunkIterator
+func (cr *ReverseTime32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseTime32ChunkIterator
+func (cr *ReverseTime32ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// Time64ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Time64ChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Time64 // cache the chunks on this iterator
+	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int           // current chunk
+	currentChunk *array.Time64 // current chunk
+}
+
+// NewTime64ChunkIterator creates a new Time64ChunkIterator for reading an Arrow Column.
+func NewTime64ChunkIterator(col *array.Column) *Time64ChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Time64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Time64)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &Time64ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: 0,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *Time64ChunkIterator) Chunk() *array.Time64 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []arrow.Time64 chunk values.
+// Keep in mind the []arrow.Time64 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *Time64ChunkIterator) ChunkValues() []arrow.Time64 {
+
+	return cr.Chunk().Time64Values()
+
+}
+
+// Next moves the iterator to the next chunk. This will return false
+// when there are no more chunks.
+func (cr *Time64ChunkIterator) Next() bool {
+	if cr.currentIndex >= len(cr.chunks) {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex++
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Time64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Time64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Time64ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Time64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Time64ChunkIterator
+func (cr *Time64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Time64ChunkIterator
+func (cr *Time64ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1076,44 +4638,209 @@ func (cr *Int32ChunkIterator) Release() {
 	}
 }
 
-// Int64ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Int64ChunkIterator struct {
+// ReverseTime64ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseTime64ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Int64 // cache the chunks on this iterator
-	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Time64 // cache the chunks on this iterator
+	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int          // current chunk
-	currentChunk *array.Int64 // current chunk
+	currentIndex int           // next chunk, counting down
+	currentChunk *array.Time64 // current chunk
 }
 
-// NewInt64ChunkIterator creates a new Int64ChunkIterator for reading an Arrow Column.
-func NewInt64ChunkIterator(col *array.Column) *Int64ChunkIterator {
+// NewReverseTime64ChunkIterator creates a new ReverseTime64ChunkIterator for reading an Arrow Column.
+func NewReverseTime64ChunkIterator(col *array.Column) *ReverseTime64ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Int64, len(columnChunks))
+	chunks := make([]*array.Time64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Int64)
+		chunks[i] = chunk.(*array.Time64)
+		// Retain the chunk
+		chunks[i].Retain()
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseTime64ChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseTime64ChunkIterator) Chunk() *array.Time64 { return cr.currentChunk }
+
+// ChunkValues returns the underlying []arrow.Time64 chunk values.
+// Keep in mind the []arrow.Time64 type might not be able
+// to account for nil values. You must check for those explicitly via the chunk.
+func (cr *ReverseTime64ChunkIterator) ChunkValues() []arrow.Time64 {
+
+	return cr.Chunk().Time64Values()
+
+}
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseTime64ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseTime64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseTime64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseTime64ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseTime64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseTime64ChunkIterator
+func (cr *ReverseTime64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseTime64ChunkIterator
+func (cr *ReverseTime64ChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// TimestampChunkIterator is an iterator for reading an Arrow Column value by value.
+type TimestampChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []*array.Timestamp // cache the chunks on this iterator
+	length int64              // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int              // current chunk
+	currentChunk *array.Timestamp // current chunk
+}
+
+// NewTimestampChunkIterator creates a new TimestampChunkIterator for reading an Arrow Column.
+func NewTimestampChunkIterator(col *array.Column) *TimestampChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]*array.Timestamp, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Keep our own refs to chunks
+		chunks[i] = chunk.(*array.Timestamp)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Int64ChunkIterator{
+	cr := &TimestampChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1122,26 +4849,31 @@ func NewInt64ChunkIterator(col *array.Column) *Int64ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Int64ChunkIterator) Chunk() *array.Int64 { return cr.currentChunk }
+func (cr *TimestampChunkIterator) Chunk() *array.Timestamp { return cr.currentChunk }
 
-// ChunkValues returns the underlying []int64 chunk values.
-// Keep in mind the []int64 type might not be able
+// ChunkValues returns the underlying []arrow.Timestamp chunk values.
+// Keep in mind the []arrow.Timestamp type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Int64ChunkIterator) ChunkValues() []int64 {
+func (cr *TimestampChunkIterator) ChunkValues() []arrow.Timestamp {
 
-	return cr.Chunk().Int64Values()
+	return cr.Chunk().TimestampValues()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *Int64ChunkIterator) Next() bool {
+func (cr *TimestampChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -1157,15 +4889,58 @@ func (cr *Int64ChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the Int64ChunkIterator
-func (cr *Int64ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *TimestampChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Int64ChunkIterator
-func (cr *Int64ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *TimestampChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *TimestampChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *TimestampChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the TimestampChunkIterator
+func (cr *TimestampChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the TimestampChunkIterator
+func (cr *TimestampChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1181,44 +4956,50 @@ func (cr *Int64ChunkIterator) Release() {
 	}
 }
 
-// Int8ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Int8ChunkIterator struct {
+// ReverseTimestampChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseTimestampChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Int8 // cache the chunks on this iterator
-	length int64         // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Timestamp // cache the chunks on this iterator
+	length int64              // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int         // current chunk
-	currentChunk *array.Int8 // current chunk
+	currentIndex int              // next chunk, counting down
+	currentChunk *array.Timestamp // current chunk
 }
 
-// NewInt8ChunkIterator creates a new Int8ChunkIterator for reading an Arrow Column.
-func NewInt8ChunkIterator(col *array.Column) *Int8ChunkIterator {
+// NewReverseTimestampChunkIterator creates a new ReverseTimestampChunkIterator for reading an Arrow Column.
+func NewReverseTimestampChunkIterator(col *array.Column) *ReverseTimestampChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Int8, len(columnChunks))
+	chunks := make([]*array.Timestamp, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Int8)
+		chunks[i] = chunk.(*array.Timestamp)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Int8ChunkIterator{
+	cr := &ReverseTimestampChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1227,27 +5008,32 @@ func NewInt8ChunkIterator(col *array.Column) *Int8ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Int8ChunkIterator) Chunk() *array.Int8 { return cr.currentChunk }
+func (cr *ReverseTimestampChunkIterator) Chunk() *array.Timestamp { return cr.currentChunk }
 
-// ChunkValues returns the underlying []int8 chunk values.
-// Keep in mind the []int8 type might not be able
+// ChunkValues returns the underlying []arrow.Timestamp chunk values.
+// Keep in mind the []arrow.Timestamp type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Int8ChunkIterator) ChunkValues() []int8 {
+func (cr *ReverseTimestampChunkIterator) ChunkValues() []arrow.Timestamp {
 
-	return cr.Chunk().Int8Values()
+	return cr.Chunk().TimestampValues()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Int8ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseTimestampChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -1257,20 +5043,63 @@ func (cr *Int8ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Int8ChunkIterator
-func (cr *Int8ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseTimestampChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Int8ChunkIterator
-func (cr *Int8ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseTimestampChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseTimestampChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseTimestampChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseTimestampChunkIterator
+func (cr *ReverseTimestampChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseTimestampChunkIterator
+func (cr *ReverseTimestampChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1286,44 +5115,50 @@ func (cr *Int8ChunkIterator) Release() {
 	}
 }
 
-// MonthIntervalChunkIterator is an iterator for reading an Arrow Column value by value.
-type MonthIntervalChunkIterator struct {
+// Uint16ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Uint16ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.MonthInterval // cache the chunks on this iterator
-	length int64                  // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Uint16 // cache the chunks on this iterator
+	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int                  // current chunk
-	currentChunk *array.MonthInterval // current chunk
+	currentIndex int           // current chunk
+	currentChunk *array.Uint16 // current chunk
 }
 
-// NewMonthIntervalChunkIterator creates a new MonthIntervalChunkIterator for reading an Arrow Column.
-func NewMonthIntervalChunkIterator(col *array.Column) *MonthIntervalChunkIterator {
+// NewUint16ChunkIterator creates a new Uint16ChunkIterator for reading an Arrow Column.
+func NewUint16ChunkIterator(col *array.Column) *Uint16ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.MonthInterval, len(columnChunks))
+	chunks := make([]*array.Uint16, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.MonthInterval)
+		chunks[i] = chunk.(*array.Uint16)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &MonthIntervalChunkIterator{
+	cr := &Uint16ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1332,26 +5167,31 @@ func NewMonthIntervalChunkIterator(col *array.Column) *MonthIntervalChunkIterato
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *MonthIntervalChunkIterator) Chunk() *array.MonthInterval { return cr.currentChunk }
+func (cr *Uint16ChunkIterator) Chunk() *array.Uint16 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []arrow.MonthInterval chunk values.
-// Keep in mind the []arrow.MonthInterval type might not be able
+// ChunkValues returns the underlying []uint16 chunk values.
+// Keep in mind the []uint16 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *MonthIntervalChunkIterator) ChunkValues() []arrow.MonthInterval {
+func (cr *Uint16ChunkIterator) ChunkValues() []uint16 {
 
-	return cr.Chunk().MonthIntervalValues()
+	return cr.Chunk().Uint16Values()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *MonthIntervalChunkIterator) Next() bool {
+func (cr *Uint16ChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -1367,15 +5207,58 @@ func (cr *MonthIntervalChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the MonthIntervalChunkIterator
-func (cr *MonthIntervalChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Uint16ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the MonthIntervalChunkIterator
-func (cr *MonthIntervalChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Uint16ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Uint16ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Uint16ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Uint16ChunkIterator
+func (cr *Uint16ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Uint16ChunkIterator
+func (cr *Uint16ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1391,44 +5274,50 @@ func (cr *MonthIntervalChunkIterator) Release() {
 	}
 }
 
-// Time32ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Time32ChunkIterator struct {
+// ReverseUint16ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseUint16ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Time32 // cache the chunks on this iterator
+	chunks []*array.Uint16 // cache the chunks on this iterator
 	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int           // current chunk
-	currentChunk *array.Time32 // current chunk
+	currentIndex int           // next chunk, counting down
+	currentChunk *array.Uint16 // current chunk
 }
 
-// NewTime32ChunkIterator creates a new Time32ChunkIterator for reading an Arrow Column.
-func NewTime32ChunkIterator(col *array.Column) *Time32ChunkIterator {
+// NewReverseUint16ChunkIterator creates a new ReverseUint16ChunkIterator for reading an Arrow Column.
+func NewReverseUint16ChunkIterator(col *array.Column) *ReverseUint16ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Time32, len(columnChunks))
+	chunks := make([]*array.Uint16, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Time32)
+		chunks[i] = chunk.(*array.Uint16)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Time32ChunkIterator{
+	cr := &ReverseUint16ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1437,27 +5326,32 @@ func NewTime32ChunkIterator(col *array.Column) *Time32ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Time32ChunkIterator) Chunk() *array.Time32 { return cr.currentChunk }
+func (cr *ReverseUint16ChunkIterator) Chunk() *array.Uint16 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []arrow.Time32 chunk values.
-// Keep in mind the []arrow.Time32 type might not be able
+// ChunkValues returns the underlying []uint16 chunk values.
+// Keep in mind the []uint16 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Time32ChunkIterator) ChunkValues() []arrow.Time32 {
+func (cr *ReverseUint16ChunkIterator) ChunkValues() []uint16 {
 
-	return cr.Chunk().Time32Values()
+	return cr.Chunk().Uint16Values()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Time32ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseUint16ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -1467,20 +5361,63 @@ func (cr *Time32ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Time32ChunkIterator
-func (cr *Time32ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseUint16ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Time32ChunkIterator
-func (cr *Time32ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseUint16ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseUint16ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseUint16ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseUint16ChunkIterator
+func (cr *ReverseUint16ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseUint16ChunkIterator
+func (cr *ReverseUint16ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1496,44 +5433,50 @@ func (cr *Time32ChunkIterator) Release() {
 	}
 }
 
-// Time64ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Time64ChunkIterator struct {
+// Uint32ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Uint32ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Time64 // cache the chunks on this iterator
+	chunks []*array.Uint32 // cache the chunks on this iterator
 	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
 	currentIndex int           // current chunk
-	currentChunk *array.Time64 // current chunk
+	currentChunk *array.Uint32 // current chunk
 }
 
-// NewTime64ChunkIterator creates a new Time64ChunkIterator for reading an Arrow Column.
-func NewTime64ChunkIterator(col *array.Column) *Time64ChunkIterator {
+// NewUint32ChunkIterator creates a new Uint32ChunkIterator for reading an Arrow Column.
+func NewUint32ChunkIterator(col *array.Column) *Uint32ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Time64, len(columnChunks))
+	chunks := make([]*array.Uint32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Time64)
+		chunks[i] = chunk.(*array.Uint32)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Time64ChunkIterator{
+	cr := &Uint32ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1542,26 +5485,31 @@ func NewTime64ChunkIterator(col *array.Column) *Time64ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Time64ChunkIterator) Chunk() *array.Time64 { return cr.currentChunk }
+func (cr *Uint32ChunkIterator) Chunk() *array.Uint32 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []arrow.Time64 chunk values.
-// Keep in mind the []arrow.Time64 type might not be able
+// ChunkValues returns the underlying []uint32 chunk values.
+// Keep in mind the []uint32 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Time64ChunkIterator) ChunkValues() []arrow.Time64 {
+func (cr *Uint32ChunkIterator) ChunkValues() []uint32 {
 
-	return cr.Chunk().Time64Values()
+	return cr.Chunk().Uint32Values()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *Time64ChunkIterator) Next() bool {
+func (cr *Uint32ChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -1577,15 +5525,58 @@ func (cr *Time64ChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the Time64ChunkIterator
-func (cr *Time64ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Uint32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Time64ChunkIterator
-func (cr *Time64ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Uint32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Uint32ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Uint32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Uint32ChunkIterator
+func (cr *Uint32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Uint32ChunkIterator
+func (cr *Uint32ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1601,44 +5592,50 @@ func (cr *Time64ChunkIterator) Release() {
 	}
 }
 
-// TimestampChunkIterator is an iterator for reading an Arrow Column value by value.
-type TimestampChunkIterator struct {
+// ReverseUint32ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseUint32ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Timestamp // cache the chunks on this iterator
-	length int64              // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Uint32 // cache the chunks on this iterator
+	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int              // current chunk
-	currentChunk *array.Timestamp // current chunk
+	currentIndex int           // next chunk, counting down
+	currentChunk *array.Uint32 // current chunk
 }
 
-// NewTimestampChunkIterator creates a new TimestampChunkIterator for reading an Arrow Column.
-func NewTimestampChunkIterator(col *array.Column) *TimestampChunkIterator {
+// NewReverseUint32ChunkIterator creates a new ReverseUint32ChunkIterator for reading an Arrow Column.
+func NewReverseUint32ChunkIterator(col *array.Column) *ReverseUint32ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Timestamp, len(columnChunks))
+	chunks := make([]*array.Uint32, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Timestamp)
+		chunks[i] = chunk.(*array.Uint32)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &TimestampChunkIterator{
+	cr := &ReverseUint32ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1647,27 +5644,32 @@ func NewTimestampChunkIterator(col *array.Column) *TimestampChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *TimestampChunkIterator) Chunk() *array.Timestamp { return cr.currentChunk }
+func (cr *ReverseUint32ChunkIterator) Chunk() *array.Uint32 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []arrow.Timestamp chunk values.
-// Keep in mind the []arrow.Timestamp type might not be able
+// ChunkValues returns the underlying []uint32 chunk values.
+// Keep in mind the []uint32 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *TimestampChunkIterator) ChunkValues() []arrow.Timestamp {
+func (cr *ReverseUint32ChunkIterator) ChunkValues() []uint32 {
 
-	return cr.Chunk().TimestampValues()
+	return cr.Chunk().Uint32Values()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *TimestampChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseUint32ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -1677,20 +5679,63 @@ func (cr *TimestampChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the TimestampChunkIterator
-func (cr *TimestampChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseUint32ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the TimestampChunkIterator
-func (cr *TimestampChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseUint32ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseUint32ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseUint32ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseUint32ChunkIterator
+func (cr *ReverseUint32ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseUint32ChunkIterator
+func (cr *ReverseUint32ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1706,44 +5751,50 @@ func (cr *TimestampChunkIterator) Release() {
 	}
 }
 
-// Uint16ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Uint16ChunkIterator struct {
+// Uint64ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Uint64ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Uint16 // cache the chunks on this iterator
+	chunks []*array.Uint64 // cache the chunks on this iterator
 	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
 	currentIndex int           // current chunk
-	currentChunk *array.Uint16 // current chunk
+	currentChunk *array.Uint64 // current chunk
 }
 
-// NewUint16ChunkIterator creates a new Uint16ChunkIterator for reading an Arrow Column.
-func NewUint16ChunkIterator(col *array.Column) *Uint16ChunkIterator {
+// NewUint64ChunkIterator creates a new Uint64ChunkIterator for reading an Arrow Column.
+func NewUint64ChunkIterator(col *array.Column) *Uint64ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Uint16, len(columnChunks))
+	chunks := make([]*array.Uint64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Uint16)
+		chunks[i] = chunk.(*array.Uint64)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Uint16ChunkIterator{
+	cr := &Uint64ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1752,26 +5803,31 @@ func NewUint16ChunkIterator(col *array.Column) *Uint16ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Uint16ChunkIterator) Chunk() *array.Uint16 { return cr.currentChunk }
+func (cr *Uint64ChunkIterator) Chunk() *array.Uint64 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []uint16 chunk values.
-// Keep in mind the []uint16 type might not be able
+// ChunkValues returns the underlying []uint64 chunk values.
+// Keep in mind the []uint64 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Uint16ChunkIterator) ChunkValues() []uint16 {
+func (cr *Uint64ChunkIterator) ChunkValues() []uint64 {
 
-	return cr.Chunk().Uint16Values()
+	return cr.Chunk().Uint64Values()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *Uint16ChunkIterator) Next() bool {
+func (cr *Uint64ChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -1787,15 +5843,58 @@ func (cr *Uint16ChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the Uint16ChunkIterator
-func (cr *Uint16ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Uint64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Uint16ChunkIterator
-func (cr *Uint16ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Uint64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Uint64ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Uint64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Uint64ChunkIterator
+func (cr *Uint64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Uint64ChunkIterator
+func (cr *Uint64ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1811,44 +5910,50 @@ func (cr *Uint16ChunkIterator) Release() {
 	}
 }
 
-// Uint32ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Uint32ChunkIterator struct {
+// ReverseUint64ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseUint64ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Uint32 // cache the chunks on this iterator
+	chunks []*array.Uint64 // cache the chunks on this iterator
 	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int           // current chunk
-	currentChunk *array.Uint32 // current chunk
+	currentIndex int           // next chunk, counting down
+	currentChunk *array.Uint64 // current chunk
 }
 
-// NewUint32ChunkIterator creates a new Uint32ChunkIterator for reading an Arrow Column.
-func NewUint32ChunkIterator(col *array.Column) *Uint32ChunkIterator {
+// NewReverseUint64ChunkIterator creates a new ReverseUint64ChunkIterator for reading an Arrow Column.
+func NewReverseUint64ChunkIterator(col *array.Column) *ReverseUint64ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Uint32, len(columnChunks))
+	chunks := make([]*array.Uint64, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Uint32)
+		chunks[i] = chunk.(*array.Uint64)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Uint32ChunkIterator{
+	cr := &ReverseUint64ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1857,27 +5962,32 @@ func NewUint32ChunkIterator(col *array.Column) *Uint32ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Uint32ChunkIterator) Chunk() *array.Uint32 { return cr.currentChunk }
+func (cr *ReverseUint64ChunkIterator) Chunk() *array.Uint64 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []uint32 chunk values.
-// Keep in mind the []uint32 type might not be able
+// ChunkValues returns the underlying []uint64 chunk values.
+// Keep in mind the []uint64 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Uint32ChunkIterator) ChunkValues() []uint32 {
+func (cr *ReverseUint64ChunkIterator) ChunkValues() []uint64 {
 
-	return cr.Chunk().Uint32Values()
+	return cr.Chunk().Uint64Values()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Uint32ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseUint64ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -1887,20 +5997,63 @@ func (cr *Uint32ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Uint32ChunkIterator
-func (cr *Uint32ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseUint64ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Uint32ChunkIterator
-func (cr *Uint32ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseUint64ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseUint64ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseUint64ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseUint64ChunkIterator
+func (cr *ReverseUint64ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseUint64ChunkIterator
+func (cr *ReverseUint64ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -1916,44 +6069,50 @@ func (cr *Uint32ChunkIterator) Release() {
 	}
 }
 
-// Uint64ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Uint64ChunkIterator struct {
+// Uint8ChunkIterator is an iterator for reading an Arrow Column value by value.
+type Uint8ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
 	// Things Chunked maintains. We're going to maintain it ourselves.
-	chunks []*array.Uint64 // cache the chunks on this iterator
-	length int64           // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	chunks []*array.Uint8 // cache the chunks on this iterator
+	length int64          // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int           // current chunk
-	currentChunk *array.Uint64 // current chunk
+	currentIndex int          // current chunk
+	currentChunk *array.Uint8 // current chunk
 }
 
-// NewUint64ChunkIterator creates a new Uint64ChunkIterator for reading an Arrow Column.
-func NewUint64ChunkIterator(col *array.Column) *Uint64ChunkIterator {
+// NewUint8ChunkIterator creates a new Uint8ChunkIterator for reading an Arrow Column.
+func NewUint8ChunkIterator(col *array.Column) *Uint8ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
-	chunks := make([]*array.Uint64, len(columnChunks))
+	chunks := make([]*array.Uint8, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
 	for i, chunk := range columnChunks {
 		// Keep our own refs to chunks
-		chunks[i] = chunk.(*array.Uint64)
+		chunks[i] = chunk.(*array.Uint8)
 		// Retain the chunk
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Uint64ChunkIterator{
+	cr := &Uint8ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -1962,26 +6121,31 @@ func NewUint64ChunkIterator(col *array.Column) *Uint64ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Uint64ChunkIterator) Chunk() *array.Uint64 { return cr.currentChunk }
+func (cr *Uint8ChunkIterator) Chunk() *array.Uint8 { return cr.currentChunk }
 
-// ChunkValues returns the underlying []uint64 chunk values.
-// Keep in mind the []uint64 type might not be able
+// ChunkValues returns the underlying []uint8 chunk values.
+// Keep in mind the []uint8 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Uint64ChunkIterator) ChunkValues() []uint64 {
+func (cr *Uint8ChunkIterator) ChunkValues() []uint8 {
 
-	return cr.Chunk().Uint64Values()
+	return cr.Chunk().Uint8Values()
 
 }
 
 // Next moves the iterator to the next chunk. This will return false
 // when there are no more chunks.
-func (cr *Uint64ChunkIterator) Next() bool {
+func (cr *Uint8ChunkIterator) Next() bool {
 	if cr.currentIndex >= len(cr.chunks) {
 		return false
 	}
@@ -1997,15 +6161,58 @@ func (cr *Uint64ChunkIterator) Next() bool {
 	return true
 }
 
-// Retain keeps a reference to the Uint64ChunkIterator
-func (cr *Uint64ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *Uint8ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Uint64ChunkIterator
-func (cr *Uint64ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *Uint8ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *Uint8ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *Uint8ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the Uint8ChunkIterator
+func (cr *Uint8ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the Uint8ChunkIterator
+func (cr *Uint8ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -2021,8 +6228,8 @@ func (cr *Uint64ChunkIterator) Release() {
 	}
 }
 
-// Uint8ChunkIterator is an iterator for reading an Arrow Column value by value.
-type Uint8ChunkIterator struct {
+// ReverseUint8ChunkIterator is an iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseUint8ChunkIterator struct {
 	refCount int64
 	col      *array.Column
 
@@ -2032,18 +6239,22 @@ type Uint8ChunkIterator struct {
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
-	currentIndex int          // current chunk
+	currentIndex int          // next chunk, counting down
 	currentChunk *array.Uint8 // current chunk
 }
 
-// NewUint8ChunkIterator creates a new Uint8ChunkIterator for reading an Arrow Column.
-func NewUint8ChunkIterator(col *array.Column) *Uint8ChunkIterator {
+// NewReverseUint8ChunkIterator creates a new ReverseUint8ChunkIterator for reading an Arrow Column.
+func NewReverseUint8ChunkIterator(col *array.Column) *ReverseUint8ChunkIterator {
 	col.Retain()
 
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
 	chunks := make([]*array.Uint8, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
@@ -2054,11 +6265,13 @@ func NewUint8ChunkIterator(col *array.Column) *Uint8ChunkIterator {
 		chunks[i].Retain()
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &Uint8ChunkIterator{
+	cr := &ReverseUint8ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -2067,27 +6280,32 @@ func NewUint8ChunkIterator(col *array.Column) *Uint8ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
-		currentIndex: 0,
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
-func (cr *Uint8ChunkIterator) Chunk() *array.Uint8 { return cr.currentChunk }
+func (cr *ReverseUint8ChunkIterator) Chunk() *array.Uint8 { return cr.currentChunk }
 
 // ChunkValues returns the underlying []uint8 chunk values.
 // Keep in mind the []uint8 type might not be able
 // to account for nil values. You must check for those explicitly via the chunk.
-func (cr *Uint8ChunkIterator) ChunkValues() []uint8 {
+func (cr *ReverseUint8ChunkIterator) ChunkValues() []uint8 {
 
 	return cr.Chunk().Uint8Values()
 
 }
 
-// Next moves the iterator to the next chunk. This will return false
+// Next moves the iterator to the previous chunk. This will return false
 // when there are no more chunks.
-func (cr *Uint8ChunkIterator) Next() bool {
-	if cr.currentIndex >= len(cr.chunks) {
+func (cr *ReverseUint8ChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
 		return false
 	}
 
@@ -2097,20 +6315,63 @@ func (cr *Uint8ChunkIterator) Next() bool {
 
 	cr.currentChunk = cr.chunks[cr.currentIndex]
 	cr.currentChunk.Retain()
-	cr.currentIndex++
+	cr.currentIndex--
 
 	return true
 }
 
-// Retain keeps a reference to the Uint8ChunkIterator
-func (cr *Uint8ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseUint8ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
 }
 
-// Release removes a reference to the Uint8ChunkIterator
-func (cr *Uint8ChunkIterator) Release() {
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseUint8ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseUint8ChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseUint8ChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseUint8ChunkIterator
+func (cr *ReverseUint8ChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseUint8ChunkIterator
+func (cr *ReverseUint8ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -2125,3 +6386,46 @@ func (cr *Uint8ChunkIterator) Release() {
 		cr.dtype = nil
 	}
 }
+
+var (
+	_ RowSeeker = (*Date32ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseDate32ChunkIterator)(nil)
+	_ RowSeeker = (*Date64ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseDate64ChunkIterator)(nil)
+	_ RowSeeker = (*DayTimeIntervalChunkIterator)(nil)
+	_ RowSeeker = (*ReverseDayTimeIntervalChunkIterator)(nil)
+	_ RowSeeker = (*Decimal128ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseDecimal128ChunkIterator)(nil)
+	_ RowSeeker = (*DurationChunkIterator)(nil)
+	_ RowSeeker = (*ReverseDurationChunkIterator)(nil)
+	_ RowSeeker = (*Float16ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseFloat16ChunkIterator)(nil)
+	_ RowSeeker = (*Float32ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseFloat32ChunkIterator)(nil)
+	_ RowSeeker = (*Float64ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseFloat64ChunkIterator)(nil)
+	_ RowSeeker = (*Int16ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseInt16ChunkIterator)(nil)
+	_ RowSeeker = (*Int32ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseInt32ChunkIterator)(nil)
+	_ RowSeeker = (*Int64ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseInt64ChunkIterator)(nil)
+	_ RowSeeker = (*Int8ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseInt8ChunkIterator)(nil)
+	_ RowSeeker = (*MonthIntervalChunkIterator)(nil)
+	_ RowSeeker = (*ReverseMonthIntervalChunkIterator)(nil)
+	_ RowSeeker = (*Time32ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseTime32ChunkIterator)(nil)
+	_ RowSeeker = (*Time64ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseTime64ChunkIterator)(nil)
+	_ RowSeeker = (*TimestampChunkIterator)(nil)
+	_ RowSeeker = (*ReverseTimestampChunkIterator)(nil)
+	_ RowSeeker = (*Uint16ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseUint16ChunkIterator)(nil)
+	_ RowSeeker = (*Uint32ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseUint32ChunkIterator)(nil)
+	_ RowSeeker = (*Uint64ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseUint64ChunkIterator)(nil)
+	_ RowSeeker = (*Uint8ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseUint8ChunkIterator)(nil)
+)
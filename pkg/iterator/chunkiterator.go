@@ -15,6 +15,7 @@
 package iterator
 
 import (
+	"sort"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
@@ -33,6 +34,9 @@ type ChunkIterator struct {
 	nulls  int64
 	dtype  arrow.DataType
 
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
 	// Things we need to maintain for the iterator
 	currentIndex int             // current chunk
 	currentChunk array.Interface // current chunk
@@ -45,6 +49,7 @@ func NewChunkIterator(col *array.Column) *ChunkIterator {
 	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
 	columnChunks := col.Data().Chunks()
 	chunks := make([]array.Interface, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
 	var length int64
 	var nulls int64
 
@@ -56,11 +61,13 @@ func NewChunkIterator(col *array.Column) *ChunkIterator {
 		chunks[i] = chunk
 
 		// Keep our own counters instead of Chunked's
+		offsets[i] = length
 		length += int64(chunk.Len())
 		nulls += int64(chunk.NullN())
 	}
+	offsets[len(columnChunks)] = length
 
-	return &ChunkIterator{
+	cr := &ChunkIterator{
 		refCount: 1,
 		col:      col,
 
@@ -69,9 +76,14 @@ func NewChunkIterator(col *array.Column) *ChunkIterator {
 		nulls:  nulls,
 		dtype:  col.DataType(),
 
+		offsets: offsets,
+
 		currentIndex: 0,
 		currentChunk: nil,
 	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
 }
 
 // Chunk will return the current chunk that the iterator is on.
@@ -95,15 +107,209 @@ func (cr *ChunkIterator) Next() bool {
 	return true
 }
 
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk after it. SeekRow returns an error when row is out of range.
+func (cr *ChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i + 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ChunkIterator) Offset() int64 {
+	if cr.currentIndex == 0 {
+		return 0
+	}
+	return cr.offsets[cr.currentIndex-1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ChunkIterator) Len() int64 { return cr.length }
+
 // Retain keeps a reference to the ChunkIterator
 func (cr *ChunkIterator) Retain() {
-	atomic.AddInt64(&cr.refCount, 1)
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
 }
 
 // Release removes a reference to the ChunkIterator
 func (cr *ChunkIterator) Release() {
 	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
 	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
+	if ref == 0 {
+		cr.col.Release()
+		for i := range cr.chunks {
+			cr.chunks[i].Release()
+		}
+		if cr.currentChunk != nil {
+			cr.currentChunk.Release()
+			cr.currentChunk = nil
+		}
+		cr.col = nil
+		cr.chunks = nil
+		cr.dtype = nil
+	}
+}
+
+// ReverseChunkIterator is a generic iterator for reading an Arrow Column chunk by chunk, starting from the last chunk.
+type ReverseChunkIterator struct {
+	refCount int64
+	col      *array.Column
+
+	// Things Chunked maintains. We're going to maintain it ourselves.
+	chunks []array.Interface // cache the chunks on this iterator
+	length int64             // this isn't set right on Chunked so we won't rely on it there. Instead we keep the correct value here.
+	nulls  int64
+	dtype  arrow.DataType
+
+	// offsets holds the row each chunk starts at followed by the length.
+	offsets []int64
+
+	// Things we need to maintain for the iterator
+	currentIndex int             // next chunk, counting down
+	currentChunk array.Interface // current chunk
+}
+
+// NewReverseChunkIterator creates a new ReverseChunkIterator for reading an Arrow Column.
+func NewReverseChunkIterator(col *array.Column) *ReverseChunkIterator {
+	col.Retain()
+
+	// Chunked is not using the correct type to keep track of length so we have to recalculate it.
+	columnChunks := col.Data().Chunks()
+	chunks := make([]array.Interface, len(columnChunks))
+	offsets := make([]int64, len(columnChunks)+1)
+	var length int64
+	var nulls int64
+
+	for i, chunk := range columnChunks {
+		// Retain the chunk
+		chunk.Retain()
+
+		// Keep our own refs to chunks
+		chunks[i] = chunk
+
+		// Keep our own counters instead of Chunked's
+		offsets[i] = length
+		length += int64(chunk.Len())
+		nulls += int64(chunk.NullN())
+	}
+	offsets[len(columnChunks)] = length
+
+	cr := &ReverseChunkIterator{
+		refCount: 1,
+		col:      col,
+
+		chunks: chunks,
+		length: length,
+		nulls:  nulls,
+		dtype:  col.DataType(),
+
+		offsets: offsets,
+
+		currentIndex: len(chunks) - 1,
+		currentChunk: nil,
+	}
+	debug.TrackNewRef(cr, *col)
+
+	return cr
+}
+
+// Chunk will return the current chunk that the iterator is on.
+func (cr *ReverseChunkIterator) Chunk() array.Interface { return cr.currentChunk }
+
+// Next moves the iterator to the previous chunk. This will return false
+// when there are no more chunks.
+func (cr *ReverseChunkIterator) Next() bool {
+	if cr.currentIndex < 0 {
+		return false
+	}
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[cr.currentIndex]
+	cr.currentChunk.Retain()
+	cr.currentIndex--
+
+	return true
+}
+
+// SeekRow moves the iterator to the chunk holding row, Offset returns the row that chunk starts at.
+// Next will continue from the chunk before it. SeekRow returns an error when row is out of range.
+func (cr *ReverseChunkIterator) SeekRow(row int64) error {
+	if err := checkRow(row, cr.length); err != nil {
+		return err
+	}
+	cr.seekRow(row)
+	return nil
+}
+
+// seekRow moves the iterator to the chunk holding row and returns the index of row within that chunk.
+// It returns false when row is out of range.
+func (cr *ReverseChunkIterator) seekRow(row int64) (int, bool) {
+	if row < 0 || row >= cr.length {
+		return 0, false
+	}
+
+	// Find the first chunk that ends after row. Empty chunks are skipped over.
+	i := sort.Search(len(cr.chunks), func(i int) bool { return cr.offsets[i+1] > row })
+
+	if cr.currentChunk != nil {
+		cr.currentChunk.Release()
+	}
+
+	cr.currentChunk = cr.chunks[i]
+	cr.currentChunk.Retain()
+	cr.currentIndex = i - 1
+
+	return int(row - cr.offsets[i]), true
+}
+
+// Offset returns the row the current chunk starts at.
+func (cr *ReverseChunkIterator) Offset() int64 {
+	if cr.currentChunk == nil {
+		return cr.length
+	}
+	return cr.offsets[cr.currentIndex+1]
+}
+
+// Len returns the number of rows in the column.
+func (cr *ReverseChunkIterator) Len() int64 { return cr.length }
+
+// Retain keeps a reference to the ReverseChunkIterator
+func (cr *ReverseChunkIterator) Retain() {
+	debug.TrackRef(cr, atomic.AddInt64(&cr.refCount, 1))
+}
+
+// Release removes a reference to the ReverseChunkIterator
+func (cr *ReverseChunkIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&cr.refCount) > 0, "too many releases")
+	ref := atomic.AddInt64(&cr.refCount, -1)
+	debug.TrackRef(cr, ref)
 	if ref == 0 {
 		cr.col.Release()
 		for i := range cr.chunks {
@@ -118,3 +324,8 @@ func (cr *ChunkIterator) Release() {
 		cr.dtype = nil
 	}
 }
+
+var (
+	_ RowSeeker = (*ChunkIterator)(nil)
+	_ RowSeeker = (*ReverseChunkIterator)(nil)
+)
@@ -20,10 +20,11 @@ import (
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/gomemtest"
 	"github.com/gomem/gomem/pkg/iterator"
 )
 
-func buildRecords(pool *memory.CheckedAllocator, t *testing.T) ([]array.Record, *arrow.Schema) {
+func buildRecords(pool memory.Allocator, t *testing.T) ([]array.Record, *arrow.Schema) {
 	schema := arrow.NewSchema(
 		[]arrow.Field{
 			{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
@@ -55,8 +56,7 @@ func buildRecords(pool *memory.CheckedAllocator, t *testing.T) ([]array.Record,
 }
 
 func TestChunkIterator(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	records, schema := buildRecords(pool, t)
 	defer func() {
@@ -88,8 +88,7 @@ func TestChunkIterator(t *testing.T) {
 }
 
 func TestInt32ChunkIterator(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	records, schema := buildRecords(pool, t)
 	defer func() {
@@ -119,3 +118,102 @@ func TestInt32ChunkIterator(t *testing.T) {
 		n++
 	}
 }
+
+func TestReverseInt32ChunkIterator(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	defer func() {
+		for i := range records {
+			records[i].Release()
+		}
+	}()
+
+	expectedPtrs := make([]*int32, len(records))
+	for i := range expectedPtrs {
+		expectedPtrs[len(records)-1-i] = &records[i].Column(0).(*array.Int32).Int32Values()[0]
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	column := tbl.Column(0)
+	cr := iterator.NewReverseInt32ChunkIterator(column)
+	defer cr.Release()
+
+	n := 0
+	for cr.Next() {
+		values := cr.ChunkValues()
+		if got, want := &values[0], expectedPtrs[n]; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		n++
+	}
+	if got, want := n, len(records); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+}
+
+func TestReverseChunkIterator(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	defer func() {
+		for i := range records {
+			records[i].Release()
+		}
+	}()
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	column := tbl.Column(1)
+	cr := iterator.NewReverseChunkIterator(column)
+	defer cr.Release()
+
+	expectedOffsets := []int64{20, 10, 0}
+	n := 0
+	for cr.Next() {
+		if got, want := cr.Chunk(), records[len(records)-1-n].Column(1); got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		if got, want := cr.Offset(), expectedOffsets[n]; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		n++
+	}
+	if got, want := n, len(records); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+}
+
+func TestChunkIteratorSeekRow(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	defer func() {
+		for i := range records {
+			records[i].Release()
+		}
+	}()
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cr := iterator.NewInt32ChunkIterator(tbl.Column(0))
+	defer cr.Release()
+
+	var seeker iterator.RowSeeker = cr
+	if err := seeker.SeekRow(25); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cr.Offset(), int64(20); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if got, want := cr.ChunkValues()[25-cr.Offset()], int32(36); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if err := seeker.SeekRow(30); err == nil {
+		t.Fatal("expected an error seeking past the last row")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/internal/debug"
+	"github.com/gomem/gomem/pkg/metadata"
+)
+
+// DictionaryValueIterator is an iterator for reading a dictionary-encoded
+// (categorical) Arrow Column value by value.
+// The column stores int32 indices into the dictionary held in the field metadata.
+type DictionaryValueIterator struct {
+	refCount      int64
+	indexIterator *Int32ValueIterator
+
+	dictionary []string
+	dataType   arrow.DataType
+}
+
+// NewDictionaryValueIterator creates a new DictionaryValueIterator for reading an Arrow Column.
+func NewDictionaryValueIterator(col *array.Column) *DictionaryValueIterator {
+	dictionary, err := metadata.DictionaryValues(col.Field().Metadata)
+	if err != nil {
+		panic(fmt.Errorf("iterator/dictionary: column %q: %w", col.Name(), err))
+	}
+
+	return &DictionaryValueIterator{
+		refCount:      1,
+		indexIterator: NewInt32ValueIterator(col),
+
+		dictionary: dictionary,
+		dataType:   arrow.BinaryTypes.String,
+	}
+}
+
+// Dictionary returns the dictionary values for the column.
+func (vr *DictionaryValueIterator) Dictionary() []string {
+	return vr.dictionary
+}
+
+// Index will return the dictionary index of the current value and a boolean value indicating if the value is actually null.
+func (vr *DictionaryValueIterator) Index() (int32, bool) {
+	return vr.indexIterator.Value()
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *DictionaryValueIterator) Value() (string, bool) {
+	idx, null := vr.indexIterator.Value()
+	if null {
+		return "", true
+	}
+	return vr.dictionary[idx], false
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *DictionaryValueIterator) ValuePointer() *string {
+	value, null := vr.Value()
+	if null {
+		return nil
+	}
+	return &value
+}
+
+// ValueInterface returns the decoded value as an interface{}.
+func (vr *DictionaryValueIterator) ValueInterface() interface{} {
+	value, null := vr.Value()
+	if null {
+		return nil
+	}
+	return value
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *DictionaryValueIterator) ValueAsJSON() (interface{}, error) {
+	value, null := vr.Value()
+	if null {
+		return nil, nil
+	}
+	return stringAsJSON(value)
+}
+
+// DataType returns the DataType of the decoded values.
+// The indices are stored as metadata.DictionaryIndexType.
+func (vr *DictionaryValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *DictionaryValueIterator) Next() bool {
+	return vr.indexIterator.Next()
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *DictionaryValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.indexIterator.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *DictionaryValueIterator) seek(row int64) bool {
+	return vr.indexIterator.seek(row)
+}
+
+// At moves the iterator to row and returns it's decoded value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *DictionaryValueIterator) At(row int64) (value string, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// Retain keeps a reference to the DictionaryValueIterator
+func (vr *DictionaryValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the DictionaryValueIterator
+func (vr *DictionaryValueIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&vr.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&vr.refCount, -1) == 0 {
+		if vr.indexIterator != nil {
+			vr.indexIterator.Release()
+			vr.indexIterator = nil
+		}
+		vr.dictionary = nil
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/metadata"
+)
+
+func TestDictionaryValueIterator(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	field := arrow.Field{
+		Name:     "f1-dict",
+		Type:     metadata.DictionaryIndexType,
+		Nullable: true,
+		Metadata: metadata.AppendDictionaryTypeMetadata(arrow.Metadata{}, []string{"blue", "green", "red"}),
+	}
+
+	b := array.NewInt32Builder(pool)
+	defer b.Release()
+
+	b.AppendValues([]int32{2, 0, 0, 1}, []bool{true, true, false, true})
+	chunk1 := b.NewArray()
+	defer chunk1.Release()
+
+	b.AppendValues([]int32{1, 2}, nil)
+	chunk2 := b.NewArray()
+	defer chunk2.Release()
+
+	chunked := array.NewChunked(field.Type, []array.Interface{chunk1, chunk2})
+	defer chunked.Release()
+
+	col := array.NewColumn(field, chunked)
+	defer col.Release()
+
+	it := iterator.NewValueIterator(col)
+	defer it.Release()
+
+	dictIt, ok := it.(*iterator.DictionaryValueIterator)
+	if !ok {
+		t.Fatalf("got=%T, want=*iterator.DictionaryValueIterator", it)
+	}
+
+	if got, want := it.DataType(), arrow.DataType(arrow.BinaryTypes.String); !arrow.TypeEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	wantValues := []interface{}{"red", "blue", nil, "green", "green", "red"}
+	wantIndices := []int32{2, 0, 0, 1, 1, 2}
+	n := 0
+	for dictIt.Next() {
+		if got, want := dictIt.ValueInterface(), wantValues[n]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		idx, null := dictIt.Index()
+		if got, want := null, wantValues[n] == nil; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		if !null && idx != wantIndices[n] {
+			t.Fatalf("got=%d, want=%d", idx, wantIndices[n])
+		}
+		jsonValue, err := dictIt.ValueAsJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := jsonValue, wantValues[n]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		n++
+	}
+	if got, want := n, len(wantValues); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package iterator
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/internal/debug"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/object"
+)
+
+// ExtensionValueIterator is an iterator for reading an Arrow Column
+// value by value for extension types, see logical.ExtensionType.
+// The values are read from the storage array and converted to the Object of the type.
+type ExtensionValueIterator struct {
+	refCount      int64
+	chunkIterator *ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index int             // current value index
+	ref   array.Interface // the chunk reference
+	done  bool            // there are no more elements for this iterator
+
+	ext      logical.ExtensionType
+	dataType arrow.DataType
+}
+
+// NewExtensionValueIterator creates a new ExtensionValueIterator for reading an Arrow Column.
+func NewExtensionValueIterator(col *array.Column, ext logical.ExtensionType) *ExtensionValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewChunkIterator(col)
+
+	return &ExtensionValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index: 0,
+		ref:   nil,
+
+		ext:      ext,
+		dataType: col.DataType(),
+	}
+}
+
+// ExtensionType returns the extension type of the values.
+func (vr *ExtensionValueIterator) ExtensionType() logical.ExtensionType {
+	return vr.ext
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+// A stored value the extension type can not read is returned as null, ValueAsJSON returns the error for it.
+func (vr *ExtensionValueIterator) Value() (object.Object, bool) {
+	o, isNull, err := vr.value()
+	if err != nil {
+		return nil, true
+	}
+	return o, isNull
+}
+
+// value returns the current value cast by the extension type, or an error when the stored value can not be cast.
+func (vr *ExtensionValueIterator) value() (object.Object, bool, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, true, nil
+	}
+	var stored interface{}
+	switch ref := vr.ref.(type) {
+	case *array.FixedSizeBinary:
+		stored = ref.Value(vr.index)
+	case *array.Binary:
+		stored = ref.Value(vr.index)
+	case *array.String:
+		stored = ref.Value(vr.index)
+	default:
+		// RegisterExtensionType only accepts the storage types above.
+		panic(fmt.Errorf("dataframe/valueiterator: unhandled extension storage type %T", vr.ref))
+	}
+	o, err := vr.ext.CastObject(stored)
+	if err != nil {
+		return nil, false, fmt.Errorf("iterator/extension: %s value at index %d: %w", vr.ext.ExtensionName(), vr.index, err)
+	}
+	return o, false, nil
+}
+
+// ValueInterface returns the value as an interface{}.
+func (vr *ExtensionValueIterator) ValueInterface() interface{} {
+	o, isNull := vr.Value()
+	if isNull {
+		return nil
+	}
+	return o
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ExtensionValueIterator) ValueAsJSON() (interface{}, error) {
+	o, isNull, err := vr.value()
+	if err != nil || isNull {
+		return nil, err
+	}
+	return vr.ext.AsJSON(o), nil
+}
+
+func (vr *ExtensionValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *ExtensionValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.ref == nil || vr.index >= vr.ref.Len() {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ExtensionValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *ExtensionValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ExtensionValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range or the stored value can not be read.
+func (vr *ExtensionValueIterator) At(row int64) (value object.Object, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	return vr.value()
+}
+
+// Retain keeps a reference to the ExtensionValueIterator
+func (vr *ExtensionValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ExtensionValueIterator
+func (vr *ExtensionValueIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&vr.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&vr.refCount, -1) == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+	}
+}
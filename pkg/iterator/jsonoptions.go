@@ -0,0 +1,389 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
+)
+
+// DecimalFormat is how decimal values are represented in JSON.
+type DecimalFormat int
+
+const (
+	// DecimalAsObject represents decimals as {"lo": ..., "hi": ...} objects holding the unscaled 128-bit integer.
+	DecimalAsObject DecimalFormat = iota
+	// DecimalAsString represents decimals as strings, such as "12.34".
+	DecimalAsString
+	// DecimalAsNumber represents decimals as numbers, such as 12.34, without losing precision in the output.
+	DecimalAsNumber
+)
+
+// BinaryEncoding is how binary values are represented in JSON.
+type BinaryEncoding int
+
+const (
+	// BinaryAsBase64 represents binary values as standard base64 strings.
+	BinaryAsBase64 BinaryEncoding = iota
+	// BinaryAsHex represents binary values as upper case hex strings.
+	BinaryAsHex
+)
+
+// NonFinitePolicy is how NaN and infinite floating point values are represented in JSON.
+type NonFinitePolicy int
+
+const (
+	// NonFiniteAsIs leaves NaN and infinite values as they are. encoding/json will refuse to encode them.
+	NonFiniteAsIs NonFinitePolicy = iota
+	// NonFiniteAsNull represents NaN and infinite values as null.
+	NonFiniteAsNull
+	// NonFiniteAsString represents NaN and infinite values as the strings "NaN", "+Inf" and "-Inf".
+	NonFiniteAsString
+)
+
+// MapFormat is how map values are represented in JSON.
+type MapFormat int
+
+const (
+	// MapAsObject represents maps as objects with the keys formatted as strings.
+	MapAsObject MapFormat = iota
+	// MapAsEntries represents maps as lists of {"key": ..., "value": ...} objects, which keeps the key types.
+	MapAsEntries
+)
+
+// JSONEncoder converts v, a non-null value of the DataType dtype as returned by ValueInterface,
+// into it's JSON representation.
+type JSONEncoder func(dtype arrow.DataType, v interface{}) (interface{}, error)
+
+// JSONOptions configures the JSON representation of values.
+// The zero value, like a nil *JSONOptions, gives the same representation as ValueAsJSON.
+type JSONOptions struct {
+	// Int64AsString represents 64-bit integers, including raw timestamps, dates, times and durations,
+	// as strings so that they don't lose precision in JavaScript.
+	Int64AsString bool
+
+	// TimeFormat is the layout, as used by time.Time.Format, for timestamps, dates and times of day.
+	// When empty they are represented by their raw integer values.
+	TimeFormat string
+
+	// TimeLocation is the time zone dates and timestamps without a TimeZone are formatted in. It defaults to UTC.
+	// Timestamps whose type has a TimeZone are formatted in it and times of day are always formatted as UTC.
+	TimeLocation *time.Location
+
+	// DecimalFormat is how decimals are represented.
+	DecimalFormat DecimalFormat
+
+	// BinaryEncoding is how binary values are represented.
+	BinaryEncoding BinaryEncoding
+
+	// NonFinite is how NaN and infinite floating point values are represented.
+	NonFinite NonFinitePolicy
+
+	// MapFormat is how maps are represented.
+	MapFormat MapFormat
+
+	encoders map[arrow.Type]JSONEncoder
+}
+
+// RegisterEncoder registers enc to be used for all the values whose DataType has the given ID,
+// instead of the built in representation.
+func (o *JSONOptions) RegisterEncoder(id arrow.Type, enc JSONEncoder) {
+	if o.encoders == nil {
+		o.encoders = make(map[arrow.Type]JSONEncoder)
+	}
+	o.encoders[id] = enc
+}
+
+// ValueAsJSON returns the current value of it as an interface{} in it's JSON representation
+// according to the options. A nil *JSONOptions uses it.ValueAsJSON.
+func (o *JSONOptions) ValueAsJSON(it ValueIterator) (interface{}, error) {
+	if o == nil {
+		return it.ValueAsJSON()
+	}
+
+	dtype := it.DataType()
+	if enc, ok := o.encoders[dtype.ID()]; ok {
+		v := it.ValueInterface()
+		if v == nil {
+			return nil, nil
+		}
+		if vi, ok := v.(ValueIterator); ok {
+			// Lists hand out a new iterator for each value.
+			defer vi.Release()
+		}
+		return enc(dtype, v)
+	}
+
+	switch vr := it.(type) {
+	case *ExtensionValueIterator:
+		// Extension values are represented by their type rather than their storage.
+		return vr.ValueAsJSON()
+
+	case *ListValueIterator:
+		elems := vr.ValueIterator()
+		if elems == nil {
+			return nil, nil
+		}
+		defer elems.Release()
+
+		list := make([]interface{}, 0)
+		for elems.Next() {
+			v, err := o.ValueAsJSON(elems)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+
+	case *StructValueIterator:
+		if vr.ValueInterface() == nil {
+			return nil, nil
+		}
+		fields := vr.dataType.Fields()
+		obj := make(map[string]interface{}, len(fields))
+		for i, field := range fields {
+			v, err := o.ValueAsJSON(vr.FieldAt(i))
+			if err != nil {
+				return nil, err
+			}
+			obj[field.Name] = v
+		}
+		return obj, nil
+
+	case *MapValueIterator:
+		return o.mapAsJSON(vr)
+
+	case *UnionValueIterator:
+		member := vr.ValueIterator()
+		if member == nil {
+			return nil, nil
+		}
+		return o.ValueAsJSON(member)
+	}
+
+	v := it.ValueInterface()
+	if v == nil {
+		return nil, nil
+	}
+	jsonValue, ok, err := o.scalarAsJSON(dtype, v)
+	if err != nil || ok {
+		return jsonValue, err
+	}
+	return it.ValueAsJSON()
+}
+
+func (o *JSONOptions) mapAsJSON(vr *MapValueIterator) (interface{}, error) {
+	keys, values := vr.KeyValueIterators()
+	if keys == nil {
+		return nil, nil
+	}
+	defer keys.Release()
+	defer values.Release()
+
+	obj := make(map[string]interface{})
+	entries := make([]interface{}, 0)
+	for keys.Next() && values.Next() {
+		key, err := o.ValueAsJSON(keys)
+		if err != nil {
+			return nil, err
+		}
+		value, err := o.ValueAsJSON(values)
+		if err != nil {
+			return nil, err
+		}
+		switch o.MapFormat {
+		case MapAsEntries:
+			entries = append(entries, map[string]interface{}{"key": key, "value": value})
+		default:
+			switch k := key.(type) {
+			case string:
+				obj[k] = value
+			default:
+				obj[fmt.Sprintf("%v", k)] = value
+			}
+		}
+	}
+
+	if o.MapFormat == MapAsEntries {
+		return entries, nil
+	}
+	return obj, nil
+}
+
+// scalarAsJSON returns the JSON representation of v, a non-null value of the DataType dtype,
+// when the options change it from the one given by ValueAsJSON.
+func (o *JSONOptions) scalarAsJSON(dtype arrow.DataType, v interface{}) (interface{}, bool, error) {
+	switch dt := dtype.(type) {
+	case *arrow.Int64Type:
+		return o.int64AsJSON(v.(int64))
+	case *arrow.Uint64Type:
+		if o.Int64AsString {
+			return strconv.FormatUint(v.(uint64), 10), true, nil
+		}
+	case *arrow.Time32Type:
+		if o.TimeFormat != "" {
+			return timestampTime(int64(v.(arrow.Time32)), dt.Unit).UTC().Format(o.TimeFormat), true, nil
+		}
+	case *arrow.Time64Type:
+		t := int64(v.(arrow.Time64))
+		if o.TimeFormat != "" {
+			return timestampTime(t, dt.Unit).UTC().Format(o.TimeFormat), true, nil
+		}
+		return o.int64AsJSON(t)
+	case *arrow.DurationType:
+		return o.int64AsJSON(int64(v.(arrow.Duration)))
+	case *arrow.TimestampType:
+		ts := int64(v.(arrow.Timestamp))
+		if o.TimeFormat == "" {
+			return o.int64AsJSON(ts)
+		}
+		if dt.TimeZone == "" {
+			return o.formatTime(timestampTime(ts, dt.Unit)), true, nil
+		}
+		loc, err := loadLocation(dt.TimeZone)
+		if err != nil {
+			return nil, false, err
+		}
+		return timestampTime(ts, dt.Unit).In(loc).Format(o.TimeFormat), true, nil
+	case *arrow.Date32Type:
+		if o.TimeFormat != "" {
+			return o.formatTime(time.Unix(int64(v.(arrow.Date32))*int64(24*time.Hour/time.Second), 0)), true, nil
+		}
+	case *arrow.Date64Type:
+		ms := int64(v.(arrow.Date64))
+		if o.TimeFormat != "" {
+			return o.formatTime(time.Unix(0, ms*int64(time.Millisecond))), true, nil
+		}
+		return o.int64AsJSON(ms)
+	case *arrow.Float16Type:
+		return o.floatAsJSON(float64(v.(float16.Num).Float32()), v.(float16.Num).Float32())
+	case *arrow.Float32Type:
+		return o.floatAsJSON(float64(v.(float32)), v)
+	case *arrow.Float64Type:
+		return o.floatAsJSON(v.(float64), v)
+	case *arrow.Decimal128Type:
+		return o.decimalAsJSON(v.(decimal128.Num), dt.Scale)
+	case *arrow.BinaryType:
+		if o.BinaryEncoding == BinaryAsHex {
+			return strings.ToUpper(hex.EncodeToString(v.([]byte))), true, nil
+		}
+		return base64.StdEncoding.EncodeToString(v.([]byte)), true, nil
+	}
+	return nil, false, nil
+}
+
+func (o *JSONOptions) int64AsJSON(v int64) (interface{}, bool, error) {
+	if o.Int64AsString {
+		return strconv.FormatInt(v, 10), true, nil
+	}
+	return v, true, nil
+}
+
+func (o *JSONOptions) floatAsJSON(f float64, v interface{}) (interface{}, bool, error) {
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return v, true, nil
+	}
+	switch o.NonFinite {
+	case NonFiniteAsNull:
+		return nil, true, nil
+	case NonFiniteAsString:
+		return strconv.FormatFloat(f, 'g', -1, 64), true, nil
+	default:
+		return v, true, nil
+	}
+}
+
+func (o *JSONOptions) decimalAsJSON(v decimal128.Num, scale int32) (interface{}, bool, error) {
+	switch o.DecimalFormat {
+	case DecimalAsString:
+		return formatDecimal(v, scale), true, nil
+	case DecimalAsNumber:
+		return json.Number(formatDecimal(v, scale)), true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+func (o *JSONOptions) formatTime(t time.Time) string {
+	loc := o.TimeLocation
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format(o.TimeFormat)
+}
+
+// locations caches the time zones loaded by loadLocation.
+var locations sync.Map
+
+// loadLocation returns the time zone with the given name, loading it only the first time it is asked for.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("iterator: invalid timestamp time zone: %w", err)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// timestampTime returns the time.Time for a timestamp in the given unit.
+func timestampTime(ts int64, unit arrow.TimeUnit) time.Time {
+	switch unit {
+	case arrow.Second:
+		return time.Unix(ts, 0)
+	case arrow.Millisecond:
+		return time.Unix(0, ts*int64(time.Millisecond))
+	case arrow.Microsecond:
+		return time.Unix(0, ts*int64(time.Microsecond))
+	default:
+		return time.Unix(0, ts)
+	}
+}
+
+// formatDecimal formats the unscaled 128-bit integer v with scale digits after the decimal point.
+func formatDecimal(v decimal128.Num, scale int32) string {
+	n := new(big.Int).Lsh(big.NewInt(v.HighBits()), 64)
+	n.Add(n, new(big.Int).SetUint64(v.LowBits()))
+
+	digits := new(big.Int).Abs(n).String()
+	sign := ""
+	if n.Sign() < 0 {
+		sign = "-"
+	}
+
+	if scale <= 0 {
+		return sign + digits + strings.Repeat("0", int(-scale))
+	}
+	if len(digits) <= int(scale) {
+		digits = strings.Repeat("0", int(scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
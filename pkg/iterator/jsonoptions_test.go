@@ -0,0 +1,243 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/smartbuilder"
+)
+
+func TestJSONOptions(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	hexOptions := &iterator.JSONOptions{BinaryEncoding: iterator.BinaryAsHex}
+	customOptions := &iterator.JSONOptions{}
+	customOptions.RegisterEncoder(arrow.INT32, func(dtype arrow.DataType, v interface{}) (interface{}, error) {
+		return fmt.Sprintf("%s:%d", dtype.Name(), v), nil
+	})
+	customOptions.RegisterEncoder(arrow.FIXED_SIZE_BINARY, func(dtype arrow.DataType, v interface{}) (interface{}, error) {
+		return fmt.Sprintf("%s:%v", dtype.Name(), v), nil
+	})
+
+	tests := []struct {
+		name   string
+		field  arrow.Field
+		values []interface{}
+		opts   *iterator.JSONOptions
+		want   string
+	}{
+		{
+			name:   "default",
+			field:  arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			values: []interface{}{int64(math.MaxInt64), nil},
+			opts:   nil,
+			want:   `[9223372036854775807,null]`,
+		},
+		{
+			name:   "int64 as string",
+			field:  arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			values: []interface{}{int64(math.MaxInt64), nil},
+			opts:   &iterator.JSONOptions{Int64AsString: true},
+			want:   `["9223372036854775807",null]`,
+		},
+		{
+			name:   "uint64 as string",
+			field:  arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Uint64},
+			values: []interface{}{uint64(math.MaxUint64)},
+			opts:   &iterator.JSONOptions{Int64AsString: true},
+			want:   `["18446744073709551615"]`,
+		},
+		{
+			name:   "timestamp format",
+			field:  arrow.Field{Name: "f", Type: arrow.FixedWidthTypes.Timestamp_ms},
+			values: []interface{}{arrow.Timestamp(1500000000123)},
+			opts:   &iterator.JSONOptions{TimeFormat: time.RFC3339Nano},
+			want:   `["2017-07-14T02:40:00.123Z"]`,
+		},
+		{
+			name:   "timestamp format and zone",
+			field:  arrow.Field{Name: "f", Type: &arrow.TimestampType{Unit: arrow.Second}},
+			values: []interface{}{arrow.Timestamp(1500000000)},
+			opts:   &iterator.JSONOptions{TimeFormat: time.RFC3339, TimeLocation: ny},
+			want:   `["2017-07-13T22:40:00-04:00"]`,
+		},
+		{
+			name:   "timestamp format in its time zone",
+			field:  arrow.Field{Name: "f", Type: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "America/New_York"}},
+			values: []interface{}{arrow.Timestamp(1500000000)},
+			opts:   &iterator.JSONOptions{TimeFormat: time.RFC3339, TimeLocation: time.UTC},
+			want:   `["2017-07-13T22:40:00-04:00"]`,
+		},
+		{
+			name:   "time32 format",
+			field:  arrow.Field{Name: "f", Type: arrow.FixedWidthTypes.Time32ms},
+			values: []interface{}{arrow.Time32(45296789)},
+			opts:   &iterator.JSONOptions{TimeFormat: "15:04:05.000", TimeLocation: ny},
+			want:   `["12:34:56.789"]`,
+		},
+		{
+			name:   "time64 format",
+			field:  arrow.Field{Name: "f", Type: arrow.FixedWidthTypes.Time64us},
+			values: []interface{}{arrow.Time64(45296000001)},
+			opts:   &iterator.JSONOptions{TimeFormat: "15:04:05.000000"},
+			want:   `["12:34:56.000001"]`,
+		},
+		{
+			name:   "date32 format",
+			field:  arrow.Field{Name: "f", Type: arrow.FixedWidthTypes.Date32},
+			values: []interface{}{arrow.Date32(17361)},
+			opts:   &iterator.JSONOptions{TimeFormat: "2006-01-02"},
+			want:   `["2017-07-14"]`,
+		},
+		{
+			name:   "decimal as object",
+			field:  arrow.Field{Name: "f", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}},
+			values: []interface{}{decimal128.FromI64(-1234)},
+			opts:   &iterator.JSONOptions{},
+			want:   `[{"lo":18446744073709550382,"hi":-1}]`,
+		},
+		{
+			name:   "decimal as string",
+			field:  arrow.Field{Name: "f", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}},
+			values: []interface{}{decimal128.FromI64(-1234), decimal128.FromI64(5)},
+			opts:   &iterator.JSONOptions{DecimalFormat: iterator.DecimalAsString},
+			want:   `["-12.34","0.05"]`,
+		},
+		{
+			name:   "decimal as number",
+			field:  arrow.Field{Name: "f", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}},
+			values: []interface{}{decimal128.FromI64(1234)},
+			opts:   &iterator.JSONOptions{DecimalFormat: iterator.DecimalAsNumber},
+			want:   `[12.34]`,
+		},
+		{
+			name:   "binary as base64",
+			field:  arrow.Field{Name: "f", Type: arrow.BinaryTypes.Binary},
+			values: []interface{}{[]byte{0xde, 0xad}},
+			opts:   &iterator.JSONOptions{},
+			want:   `["3q0="]`,
+		},
+		{
+			name:   "binary as hex",
+			field:  arrow.Field{Name: "f", Type: arrow.BinaryTypes.Binary},
+			values: []interface{}{[]byte{0xde, 0xad}},
+			opts:   hexOptions,
+			want:   `["DEAD"]`,
+		},
+		{
+			name:   "non-finite as null",
+			field:  arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Float64},
+			values: []interface{}{math.NaN(), math.Inf(1), 1.5},
+			opts:   &iterator.JSONOptions{NonFinite: iterator.NonFiniteAsNull},
+			want:   `[null,null,1.5]`,
+		},
+		{
+			name:   "non-finite as string",
+			field:  arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Float32},
+			values: []interface{}{float32(math.NaN()), float32(math.Inf(-1))},
+			opts:   &iterator.JSONOptions{NonFinite: iterator.NonFiniteAsString},
+			want:   `["NaN","-Inf"]`,
+		},
+		{
+			name:   "map as object",
+			field:  logical.MapField("f", arrow.PrimitiveTypes.Int64, arrow.BinaryTypes.String),
+			values: []interface{}{map[int64]string{1: "a"}},
+			opts:   &iterator.JSONOptions{Int64AsString: true},
+			want:   `[{"1":"a"}]`,
+		},
+		{
+			name:   "map as entries",
+			field:  logical.MapField("f", arrow.PrimitiveTypes.Int64, arrow.BinaryTypes.String),
+			values: []interface{}{map[int64]string{1: "a", 2: "b"}},
+			opts:   &iterator.JSONOptions{MapFormat: iterator.MapAsEntries},
+			want:   `[[{"key":1,"value":"a"},{"key":2,"value":"b"}]]`,
+		},
+		{
+			name:   "nested",
+			field:  arrow.Field{Name: "f", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64), Nullable: true},
+			values: []interface{}{[]int64{1, 2}, nil},
+			opts:   &iterator.JSONOptions{Int64AsString: true},
+			want:   `[["1","2"],null]`,
+		},
+		{
+			name:   "custom encoder",
+			field:  arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			values: []interface{}{int32(7), nil},
+			opts:   customOptions,
+			want:   `["int32:7",null]`,
+		},
+		{
+			name:   "custom encoder for extension storage",
+			field:  logical.ExtensionField("f", logical.IPv4Type{}, true),
+			values: []interface{}{"10.0.0.1", nil},
+			opts:   customOptions,
+			want:   `["fixed_size_binary:10.0.0.1",null]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schema := arrow.NewSchema([]arrow.Field{tc.field}, nil)
+			rb := array.NewRecordBuilder(pool, schema)
+			defer rb.Release()
+
+			sb := smartbuilder.NewSmartBuilder(rb)
+			for _, v := range tc.values {
+				if err := sb.Append(0, v); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			rec := rb.NewRecord()
+			defer rec.Release()
+
+			it := iterator.NewInterfaceValueIterator(tc.field, rec.Column(0))
+			defer it.Release()
+
+			got := make([]interface{}, 0, len(tc.values))
+			for it.Next() {
+				v, err := tc.opts.ValueAsJSON(it)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, v)
+			}
+
+			b, err := json.Marshal(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := string(b), tc.want; got != want {
+				t.Fatalf("got=%s, want=%s", got, want)
+			}
+		})
+	}
+}
@@ -15,7 +15,6 @@
 package iterator
 
 import (
-	"fmt"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
@@ -55,8 +54,10 @@ func NewListValueIterator(col *array.Column) *ListValueIterator {
 	}
 }
 
-func (vr *ListValueIterator) ValueInterface() interface{} {
-	fmt.Println("called ListValueIterator ValueInterface")
+// ValueIterator returns a ValueIterator over the elements of the current list.
+// It will return nil if the list is actually null.
+// The caller is responsible for releasing the returned iterator.
+func (vr *ListValueIterator) ValueIterator() ValueIterator {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -72,25 +73,26 @@ func (vr *ListValueIterator) ValueInterface() interface{} {
 	)
 }
 
+// ValueInterface returns a ValueIterator over the elements of the current list.
+// It will return nil if the list is actually null.
+// The caller is responsible for releasing the returned iterator.
+func (vr *ListValueIterator) ValueInterface() interface{} {
+	it := vr.ValueIterator()
+	if it == nil {
+		return nil
+	}
+	return it
+}
+
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
 func (vr *ListValueIterator) ValueAsJSON() (interface{}, error) {
-	if vr.ref.IsNull(vr.index) {
+	iter := vr.ValueIterator()
+	if iter == nil {
 		return nil, nil
 	}
-
-	j := vr.index + vr.ref.Offset() // index + data offset
-	offsets := vr.ref.Offsets()
-	beg := offsets[j]
-	end := offsets[j+1]
-	arr := array.NewSlice(vr.ref.ListValues(), int64(beg), int64(end))
-	defer arr.Release()
-	iter := NewInterfaceValueIterator(
-		arrow.Field{Name: "item", Type: vr.elemDataType, Nullable: true},
-		arr,
-	)
 	defer iter.Release()
 
-	list := make([]interface{}, 0, end-beg)
+	list := make([]interface{}, 0)
 	for iter.Next() {
 		jsonValue, err := iter.ValueAsJSON()
 		if err != nil {
@@ -147,6 +149,36 @@ func (vr *ListValueIterator) nextChunk() bool {
 	return true
 }
 
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *ListValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ListValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref.(*array.List)
+	vr.index = index
+	vr.done = false
+	return true
+}
+
 // Retain keeps a reference to the ListValueIterator
 func (vr *ListValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
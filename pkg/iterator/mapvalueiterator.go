@@ -0,0 +1,229 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/internal/debug"
+	"github.com/gomem/gomem/pkg/logical"
+)
+
+// MapValueIterator iterates over the map elements.
+// Maps are stored as a list of key/value entries, see logical.MapOf.
+// For example, in a column like: [{"a": 1, "b": 2} (null) {"c": 3}]
+// First {"a": 1, "b": 2} would be returned, then (null), then {"c": 3}.
+type MapValueIterator struct {
+	refCount      int64
+	chunkIterator *ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index int         // current value index
+	ref   *array.List // the chunk reference
+	done  bool        // there are no more elements for this iterator
+
+	dataType   arrow.DataType
+	keyField   arrow.Field
+	valueField arrow.Field
+}
+
+// NewMapValueIterator creates a new MapValueIterator for reading an Arrow Column.
+func NewMapValueIterator(col *array.Column) *MapValueIterator {
+	keyField, valueField, ok := logical.MapKeyValueFields(col.DataType())
+	if !ok {
+		panic(fmt.Errorf("iterator/map: column %q has invalid map type %s", col.Name(), col.DataType()))
+	}
+
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewChunkIterator(col)
+	return &MapValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index: 0,
+		ref:   nil,
+
+		dataType:   col.DataType(),
+		keyField:   keyField,
+		valueField: valueField,
+	}
+}
+
+// KeyValueIterators returns iterators over the keys and values of the current map.
+// Both iterators will be nil if the current map is null.
+// The caller is responsible for releasing the iterators.
+func (vr *MapValueIterator) KeyValueIterators() (ValueIterator, ValueIterator) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+
+	j := vr.index + vr.ref.Offset() // index + data offset
+	offsets := vr.ref.Offsets()
+	beg := int64(offsets[j])
+	end := int64(offsets[j+1])
+	entries := array.NewSlice(vr.ref.ListValues(), beg, end).(*array.Struct)
+	defer entries.Release()
+
+	return NewInterfaceValueIterator(vr.keyField, entries.Field(0)),
+		NewInterfaceValueIterator(vr.valueField, entries.Field(1))
+}
+
+// ValueInterface returns the current map as a map[interface{}]interface{}.
+// It will return nil if the map is actually null.
+func (vr *MapValueIterator) ValueInterface() interface{} {
+	keys, values := vr.KeyValueIterators()
+	if keys == nil {
+		return nil
+	}
+	defer keys.Release()
+	defer values.Release()
+
+	m := make(map[interface{}]interface{})
+	for keys.Next() && values.Next() {
+		m[keys.ValueInterface()] = values.ValueInterface()
+	}
+	return m
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+// Maps are represented as JSON objects with the keys formatted as strings.
+func (vr *MapValueIterator) ValueAsJSON() (interface{}, error) {
+	keys, values := vr.KeyValueIterators()
+	if keys == nil {
+		return nil, nil
+	}
+	defer keys.Release()
+	defer values.Release()
+
+	obj := make(map[string]interface{})
+	for keys.Next() && values.Next() {
+		key, err := keys.ValueAsJSON()
+		if err != nil {
+			return nil, err
+		}
+		value, err := values.ValueAsJSON()
+		if err != nil {
+			return nil, err
+		}
+		switch k := key.(type) {
+		case string:
+			obj[k] = value
+		default:
+			obj[fmt.Sprintf("%v", k)] = value
+		}
+	}
+
+	return obj, nil
+}
+
+func (vr *MapValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+func (vr *MapValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.ref == nil || vr.index >= vr.ref.Len() {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *MapValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref.(*array.List)
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *MapValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *MapValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref.(*array.List)
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the MapValueIterator
+func (vr *MapValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the MapValueIterator
+func (vr *MapValueIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&vr.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&vr.refCount, -1) == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/smartbuilder"
+)
+
+func TestMapValueIterator(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	field := logical.MapField("f1-map", arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int64)
+	schema := arrow.NewSchema([]arrow.Field{field}, nil)
+
+	rb := array.NewRecordBuilder(pool, schema)
+	defer rb.Release()
+
+	sb := smartbuilder.NewSmartBuilder(rb)
+	values := []interface{}{
+		map[string]int64{"skipped": 0},
+		map[string]int64{"b": 2, "a": 1},
+		nil,
+		map[string]int64{},
+		map[string]interface{}{"c": int64(3), "d": nil},
+	}
+	for _, v := range values {
+		if err := sb.Append(0, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	arr := rb.Field(0).NewArray()
+	defer arr.Release()
+
+	// Slice off the first map to make sure the offsets are respected.
+	slice := array.NewSlice(arr, 1, int64(arr.Len()))
+	defer slice.Release()
+
+	chunked := array.NewChunked(field.Type, []array.Interface{slice})
+	defer chunked.Release()
+
+	col := array.NewColumn(field, chunked)
+	defer col.Release()
+
+	it := iterator.NewValueIterator(col)
+	defer it.Release()
+
+	mapIt, ok := it.(*iterator.MapValueIterator)
+	if !ok {
+		t.Fatalf("got=%T, want=*iterator.MapValueIterator", it)
+	}
+
+	wantValues := []interface{}{
+		map[interface{}]interface{}{"a": int64(1), "b": int64(2)},
+		nil,
+		map[interface{}]interface{}{},
+		map[interface{}]interface{}{"c": int64(3), "d": nil},
+	}
+	wantJSON := []interface{}{
+		map[string]interface{}{"a": int64(1), "b": int64(2)},
+		nil,
+		map[string]interface{}{},
+		map[string]interface{}{"c": int64(3), "d": nil},
+	}
+	n := 0
+	for mapIt.Next() {
+		if got, want := mapIt.ValueInterface(), wantValues[n]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%#v, want=%#v", got, want)
+		}
+		got, err := mapIt.ValueAsJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := wantJSON[n]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%#v, want=%#v", got, want)
+		}
+		n++
+	}
+	if got, want := n, len(wantValues); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+}
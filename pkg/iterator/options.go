@@ -0,0 +1,215 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// Option is an option that may be passed to a function.
+type Option func(interface{}) error
+
+type stepIteratorConfig struct {
+	columns       []string
+	filter        func(*StepValue) bool
+	filterColumns []string
+	jsonOptions   *JSONOptions
+	newValues     bool
+}
+
+type recordReaderConfig struct {
+	columns   []string
+	batchSize int64
+}
+
+// WithColumns configures a StepIterator or record reader to only read the columns with the given names, in that order.
+func WithColumns(names ...string) Option {
+	return func(p interface{}) error {
+		switch o := p.(type) {
+		case *stepIteratorConfig:
+			o.columns = names
+		case *recordReaderConfig:
+			o.columns = names
+		default:
+			return fmt.Errorf("cannot apply WithColumns to: %T", p)
+		}
+		return nil
+	}
+}
+
+// WithFilter configures a StepIterator to skip the rows that fn returns false for.
+// When columns are given fn is only given their values, in that order, and the values of the
+// other columns are not read for the rows it skips. Otherwise fn is given the values of every column.
+// The StepValue given to fn is only valid during the call.
+func WithFilter(fn func(*StepValue) bool, columns ...string) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*stepIteratorConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithFilter to: %T", p)
+		}
+		o.filter = fn
+		o.filterColumns = columns
+		return nil
+	}
+}
+
+// WithJSONOptions configures the JSON representation of the values returned by a StepIterator's ValuesJSON.
+func WithJSONOptions(opts *JSONOptions) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*stepIteratorConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithJSONOptions to: %T", p)
+		}
+		o.jsonOptions = opts
+		return nil
+	}
+}
+
+// WithNewStepValues configures a StepIterator to allocate a new StepValue for each step
+// so the values returned by Values and ValuesJSON can be kept after the iterator moves.
+func WithNewStepValues() Option {
+	return func(p interface{}) error {
+		o, ok := p.(*stepIteratorConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithNewStepValues to: %T", p)
+		}
+		o.newValues = true
+		return nil
+	}
+}
+
+// WithBatchSize configures a record reader to yield records of at most n rows.
+// If n is <= 0, the biggest possible records will be yielded.
+func WithBatchSize(n int64) Option {
+	return func(p interface{}) error {
+		o, ok := p.(*recordReaderConfig)
+		if !ok {
+			return fmt.Errorf("cannot apply WithBatchSize to: %T", p)
+		}
+		o.batchSize = n
+		return nil
+	}
+}
+
+// NewStepIteratorWithOptions creates a new StepIterator given a slice of columns and options.
+// Use WithColumns to project a subset of the columns, WithFilter to skip rows and WithJSONOptions to configure ValuesJSON.
+// The StepValue returned by Values and ValuesJSON is reused between steps, it and it's slices are overwritten
+// by the next call to Next or SeekRow so they must not be kept past it. Use WithNewStepValues to keep them.
+func NewStepIteratorWithOptions(cols []array.Column, opts ...Option) (StepIterator, error) {
+	cfg := &stepIteratorConfig{}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	cols, err := projectColumns(cols, cfg.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	filterColumns, err := columnIndices(cols, cfg.filterColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	it := newStepIteratorForColumns(cols)
+	it.filter = cfg.filter
+	it.jsonOptions = cfg.jsonOptions
+	it.reuse = !cfg.newValues
+	if len(filterColumns) > 0 {
+		it.filterColumns = filterColumns
+		it.filterStep = &StepValue{
+			Values: make([]interface{}, len(filterColumns)),
+			Exists: make([]bool, len(filterColumns)),
+			Dtypes: make([]arrow.DataType, len(filterColumns)),
+		}
+		for i, c := range filterColumns {
+			it.filterStep.Dtypes[i] = it.dtypes[c]
+		}
+	}
+	return it, nil
+}
+
+// NewRecordReaderForColumns creates a new RecordReader that yields the columns as records.
+// The records are aligned across all the columns, even when their chunk boundaries differ,
+// and share memory with the columns.
+// Use WithColumns to project a subset of the columns and WithBatchSize to limit the size of the records.
+func NewRecordReaderForColumns(cols []array.Column, opts ...Option) (array.RecordReader, error) {
+	cfg := &recordReaderConfig{}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	cols, err := projectColumns(cols, cfg.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]arrow.Field, len(cols))
+	for i := range cols {
+		if cols[i].Len() != cols[0].Len() {
+			return nil, fmt.Errorf("iterator: column %q has %d rows but column %q has %d", cols[i].Name(), cols[i].Len(), cols[0].Name(), cols[0].Len())
+		}
+		fields[i] = cols[i].Field()
+	}
+
+	tbl := array.NewTable(arrow.NewSchema(fields, nil), cols, -1)
+	defer tbl.Release()
+
+	return array.NewTableReader(tbl, cfg.batchSize), nil
+}
+
+// projectColumns returns the columns with the given names, in that order.
+// All the columns are returned when no names are given.
+// columnIndices returns the index in cols of each of the named columns.
+func columnIndices(cols []array.Column, names []string) ([]int, error) {
+	indices := make([]int, 0, len(names))
+	for _, name := range names {
+		found := false
+		for i := range cols {
+			if cols[i].Name() == name {
+				indices = append(indices, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("iterator: unknown column %q", name)
+		}
+	}
+	return indices, nil
+}
+
+func projectColumns(cols []array.Column, names []string) ([]array.Column, error) {
+	if len(names) == 0 {
+		return cols, nil
+	}
+
+	indices, err := columnIndices(cols, names)
+	if err != nil {
+		return nil, err
+	}
+	projected := make([]array.Column, len(indices))
+	for i, c := range indices {
+		projected[i] = cols[c]
+	}
+	return projected, nil
+}
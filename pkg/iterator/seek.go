@@ -0,0 +1,49 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"fmt"
+)
+
+// RowSeeker is implemented by the iterators that can be moved to any row.
+// All the ValueIterators created by this package implement it. It is not part of
+// the ValueIterator interface so other implementations do not have to support it.
+type RowSeeker interface {
+	// SeekRow moves the iterator to row so that it becomes the current value.
+	// Next will continue from the row after it. SeekRow returns an error when row is out of range.
+	SeekRow(row int64) error
+}
+
+// checkRow returns an error when row is out of range for the number of rows.
+func checkRow(row, length int64) error {
+	if row < 0 || row >= length {
+		return fmt.Errorf("iterator: row %d out of range [0, %d)", row, length)
+	}
+	return nil
+}
+
+var (
+	_ RowSeeker    = (*BinaryValueIterator)(nil)
+	_ RowSeeker    = (*BooleanValueIterator)(nil)
+	_ RowSeeker    = (*DictionaryValueIterator)(nil)
+	_ RowSeeker    = (*ExtensionValueIterator)(nil)
+	_ RowSeeker    = (*ListValueIterator)(nil)
+	_ RowSeeker    = (*MapValueIterator)(nil)
+	_ RowSeeker    = (*StringValueIterator)(nil)
+	_ RowSeeker    = (*StructValueIterator)(nil)
+	_ RowSeeker    = (*UnionValueIterator)(nil)
+	_ StepIterator = (*stepIterator)(nil)
+)
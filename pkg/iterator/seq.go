@@ -0,0 +1,92 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// Values returns a sequence of the rows and values in col for use with range.
+// The value is nil when it is actually null. Values of primitive types point into
+// the column's memory, so they must be copied to be kept after the loop ends.
+// It panics when the values in col are not of type T.
+// The underlying iterator is released when the loop ends, even when it is broken out of.
+func Values[T any](col *array.Column) iter.Seq2[int64, *T] {
+	return func(yield func(int64, *T) bool) {
+		it := NewValueIterator(col)
+		defer it.Release()
+
+		// The typed iterators can hand out values without boxing them.
+		if typed, ok := it.(interface{ ValuePointer() *T }); ok {
+			for row := int64(0); it.Next(); row++ {
+				if !yield(row, typed.ValuePointer()) {
+					return
+				}
+			}
+			return
+		}
+
+		for row := int64(0); it.Next(); row++ {
+			v := it.ValueInterface()
+			if v == nil {
+				if !yield(row, nil) {
+					return
+				}
+				continue
+			}
+			value, ok := v.(T)
+			if !ok {
+				panic(fmt.Errorf("iterator: cannot use %T value from %s column as %T", v, col.DataType().Name(), value))
+			}
+			if !yield(row, &value) {
+				return
+			}
+		}
+	}
+}
+
+// Rows returns a sequence of the rows and their values in cols, read together with a StepIterator, for use with range.
+// The underlying iterator is released when the loop ends, even when it is broken out of.
+func Rows(cols []array.Column) iter.Seq2[int64, *StepValue] {
+	return func(yield func(int64, *StepValue) bool) {
+		it := NewStepIteratorForColumns(cols)
+		defer it.Release()
+
+		for row := int64(0); it.Next(); row++ {
+			if !yield(row, it.Values()) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns a sequence of the chunks in col, keyed by the row each chunk starts at, for use with range.
+// The chunks are only retained for the duration of the loop.
+// The underlying iterator is released when the loop ends, even when it is broken out of.
+func Chunks(col *array.Column) iter.Seq2[int64, array.Interface] {
+	return func(yield func(int64, array.Interface) bool) {
+		it := NewChunkIterator(col)
+		defer it.Release()
+
+		for it.Next() {
+			if !yield(it.Offset(), it.Chunk()) {
+				return
+			}
+		}
+	}
+}
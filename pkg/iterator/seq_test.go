@@ -0,0 +1,120 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/iterator"
+)
+
+func TestValues(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	var n int64
+	for row, v := range iterator.Values[int32](tbl.Column(0)) {
+		if got, want := row, n; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		// row 8 is null
+		if got, want := v == nil, row == 8; got != want {
+			t.Fatalf("row %d: got=%v, want=%v", row, got, want)
+		}
+		n++
+	}
+	if got, want := n, int64(30); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+
+	// Breaking out of the loop must release the iterator.
+	for row, v := range iterator.Values[float64](tbl.Column(1)) {
+		if got, want := *v, float64(row+1); got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		if row == 2 {
+			break
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic reading an int32 column as int64")
+		}
+	}()
+	for range iterator.Values[int64](tbl.Column(0)) {
+	}
+}
+
+func TestRows(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cols := make([]array.Column, 0, tbl.NumCols())
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		cols = append(cols, *tbl.Column(i))
+	}
+
+	for row, step := range iterator.Rows(cols) {
+		if got, want := step.Values[1], float64(row+1); got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		if row == 5 {
+			break
+		}
+	}
+}
+
+func TestChunks(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	n := 0
+	for offset, chunk := range iterator.Chunks(tbl.Column(0)) {
+		if got, want := offset, int64(n*10); got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		if got, want := chunk, records[n].Column(0); got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		n++
+	}
+	if got, want := n, len(records); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+}
@@ -15,6 +15,7 @@
 package iterator
 
 import (
+	"fmt"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
@@ -42,6 +43,14 @@ type StepIterator interface {
 	Next() bool
 	Retain()
 	Release()
+
+	// SeekRow moves all the iterators to row so that it becomes the current step.
+	// It returns an error when row is out of range for all the iterators.
+	RowSeeker
+
+	// At moves all the iterators to row and returns the values for it.
+	// It returns an error when row is out of range for all the iterators.
+	At(row int64) (*StepValue, error)
 }
 
 // stepIterator has a max number of elements it
@@ -53,26 +62,50 @@ type stepIterator struct {
 	index     uint64
 	stepValue *StepValue
 	dtypes    []arrow.DataType
+
+	// reuse is true when the StepValue and it's buffers are reused between steps.
+	reuse      bool
+	values     []interface{}
+	valuesJSON []interface{}
+
+	// filter skips the rows it returns false for.
+	// When filterColumns is set it is given filterStep, holding only the values of those columns.
+	filter        func(*StepValue) bool
+	filterColumns []int
+	filterStep    *StepValue
+
+	// jsonOptions configures the values returned by ValuesJSON.
+	jsonOptions *JSONOptions
 }
 
 // NewStepIteratorForColumns creates a new StepIterator given a slice of columns.
 func NewStepIteratorForColumns(cols []array.Column) StepIterator {
+	return newStepIteratorForColumns(cols)
+}
+
+func newStepIteratorForColumns(cols []array.Column) *stepIterator {
 	itrs := make([]ValueIterator, 0, len(cols))
 	dtypes := make([]arrow.DataType, 0, len(cols))
 	for i := range cols {
-		itrs = append(itrs, NewValueIterator(&cols[i]))
-		dtypes = append(dtypes, cols[i].DataType())
+		it := NewValueIterator(&cols[i])
+		itrs = append(itrs, it)
+		// Logical types may present a different DataType than the one they are stored as.
+		dtypes = append(dtypes, it.DataType())
 	}
-	// NewStepIterator will retain the value iterators refs
+	// newStepIterator will retain the value iterators refs
 	// so we need to remove our ref to them.
 	for i := range itrs {
 		defer itrs[i].Release()
 	}
-	return NewStepIterator(dtypes, itrs...)
+	return newStepIterator(dtypes, itrs...)
 }
 
 // NewStepIterator creates a new StepIterator given a bunch of ValueIterators.
 func NewStepIterator(dtypes []arrow.DataType, iterators ...ValueIterator) StepIterator {
+	return newStepIterator(dtypes, iterators...)
+}
+
+func newStepIterator(dtypes []arrow.DataType, iterators ...ValueIterator) *stepIterator {
 	for i := range iterators {
 		iterators[i].Retain()
 	}
@@ -80,67 +113,157 @@ func NewStepIterator(dtypes []arrow.DataType, iterators ...ValueIterator) StepIt
 		refCount:  1,
 		iterators: iterators,
 		index:     0,
-		dtypes:    dtypes,
+		stepValue: &StepValue{
+			Exists: make([]bool, len(iterators)),
+			Dtypes: dtypes,
+		},
+		dtypes: dtypes,
 	}
 }
 
 // Values returns the values in the current step as a StepValue.
+// Each step has it's own StepValue unless the iterator was created by NewStepIteratorWithOptions,
+// in which case it is only valid until the iterator moves.
 func (s *stepIterator) Values() *StepValue {
 	if s.stepValue.Values != nil {
 		return s.stepValue
 	}
 
-	s.stepValue.Values = make([]interface{}, len(s.iterators))
+	if !s.reuse || s.values == nil {
+		s.values = make([]interface{}, len(s.iterators))
+	}
 	for i, iterator := range s.iterators {
 		if s.stepValue.Exists[i] {
-			s.stepValue.Values[i] = iterator.ValueInterface()
+			s.values[i] = iterator.ValueInterface()
 		} else {
-			s.stepValue.Values[i] = nil
+			s.values[i] = nil
 		}
 	}
+	s.stepValue.Values = s.values
 
 	return s.stepValue
 }
 
 // ValuesJSON returns the json values in the current step as a StepValue.
+// Each step has it's own StepValue unless the iterator was created by NewStepIteratorWithOptions,
+// in which case it is only valid until the iterator moves.
 func (s *stepIterator) ValuesJSON() (*StepValue, error) {
 	if s.stepValue.ValuesJSON != nil {
 		return s.stepValue, nil
 	}
 
+	if !s.reuse || s.valuesJSON == nil {
+		s.valuesJSON = make([]interface{}, len(s.iterators))
+	}
 	var err error
-	s.stepValue.ValuesJSON = make([]interface{}, len(s.iterators))
 	for i, iterator := range s.iterators {
 		if s.stepValue.Exists[i] {
-			s.stepValue.ValuesJSON[i], err = iterator.ValueAsJSON()
+			s.valuesJSON[i], err = s.jsonOptions.ValueAsJSON(iterator)
 			if err != nil {
 				return nil, err
 			}
 		} else {
-			s.stepValue.ValuesJSON[i] = nil
+			s.valuesJSON[i] = nil
 		}
 	}
+	s.stepValue.ValuesJSON = s.valuesJSON
 	return s.stepValue, nil
 }
 
+// resetStep clears the StepValue for a new step.
+// A new StepValue is created unless it is reused between steps.
+func (s *stepIterator) resetStep() {
+	if !s.reuse {
+		s.stepValue = &StepValue{
+			Exists: make([]bool, len(s.iterators)),
+			Dtypes: s.dtypes,
+		}
+		return
+	}
+	s.stepValue.Values = nil
+	s.stepValue.ValuesJSON = nil
+	for i := range s.stepValue.Exists {
+		s.stepValue.Exists[i] = false
+	}
+}
+
 // Next returns false when there are no more rows in any iterator.
 func (s *stepIterator) Next() bool {
-	// build the step values
-	step := &StepValue{
-		Values: nil,
-		Exists: make([]bool, len(s.iterators)),
-		Dtypes: s.dtypes,
+	for {
+		s.resetStep()
+
+		next := false
+		for i, iterator := range s.iterators {
+			exists := iterator.Next()
+			next = exists || next
+			s.stepValue.Exists[i] = exists
+		}
+
+		if !next {
+			return false
+		}
+		if s.keep() {
+			return true
+		}
+	}
+}
+
+// keep returns true when the current row passes the filter.
+// Only the values of the filter columns are read when it has some.
+func (s *stepIterator) keep() bool {
+	if s.filter == nil {
+		return true
 	}
+	if s.filterStep == nil {
+		return s.filter(s.Values())
+	}
+	for i, c := range s.filterColumns {
+		exists := s.stepValue.Exists[c]
+		s.filterStep.Exists[i] = exists
+		s.filterStep.Values[i] = nil
+		if exists {
+			s.filterStep.Values[i] = s.iterators[c].ValueInterface()
+		}
+	}
+	return s.filter(s.filterStep)
+}
 
-	next := false
+// SeekRow moves all the iterators to row so that it becomes the current step. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range for all the iterators.
+// The row filter is not applied to the row sought to.
+func (s *stepIterator) SeekRow(row int64) error {
+	s.resetStep()
+
+	var err error
+	found := false
 	for i, iterator := range s.iterators {
-		exists := iterator.Next()
-		next = exists || next
-		step.Exists[i] = exists
+		seeker, ok := iterator.(RowSeeker)
+		if !ok {
+			return fmt.Errorf("iterator: %T does not implement RowSeeker", iterator)
+		}
+		if seekErr := seeker.SeekRow(row); seekErr != nil {
+			if err == nil {
+				err = seekErr
+			}
+			continue
+		}
+		s.stepValue.Exists[i] = true
+		found = true
 	}
 
-	s.stepValue = step
-	return next
+	if !found {
+		return err
+	}
+	return nil
+}
+
+// At moves all the iterators to row and returns the values for it.
+// It returns an error when row is out of range for all the iterators.
+func (s *stepIterator) At(row int64) (*StepValue, error) {
+	if err := s.SeekRow(row); err != nil {
+		return nil, err
+	}
+	return s.Values(), nil
 }
 
 func (s *stepIterator) Retain() {
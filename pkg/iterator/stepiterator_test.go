@@ -15,16 +15,17 @@
 package iterator_test
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
-	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/gomemtest"
 	"github.com/gomem/gomem/pkg/iterator"
 )
 
 func TestNewStepIteratorForColumns(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	records, schema := buildRecords(pool, t)
 	for i := range records {
@@ -42,3 +43,260 @@ func TestNewStepIteratorForColumns(t *testing.T) {
 	it := iterator.NewStepIteratorForColumns(cols)
 	defer it.Release()
 }
+
+func TestStepIteratorSeek(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cols := make([]array.Column, 0, tbl.NumCols())
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		cols = append(cols, *tbl.Column(i))
+	}
+
+	it := iterator.NewStepIteratorForColumns(cols)
+	defer it.Release()
+
+	if err := it.SeekRow(21); err != nil {
+		t.Fatal(err)
+	}
+	values := it.Values()
+	if got, want := values.Values[0], int32(32); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := values.Values[1], float64(32); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if !it.Next() {
+		t.Fatal("expected a step after row 21")
+	}
+	if got, want := it.Values().Values[0], int32(33); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	values, err := it.At(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := values.Values[0], int32(6); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := values.Values[1], float64(6); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if err := it.SeekRow(30); err == nil {
+		t.Fatal("expected an error seeking past the end")
+	}
+	if _, err := it.At(-1); err == nil {
+		t.Fatal("expected an error reading a negative row")
+	}
+}
+
+func TestNewStepIteratorWithOptions(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cols := make([]array.Column, 0, tbl.NumCols())
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		cols = append(cols, *tbl.Column(i))
+	}
+
+	it, err := iterator.NewStepIteratorWithOptions(cols,
+		iterator.WithColumns("f2-f64"),
+		iterator.WithFilter(func(step *iterator.StepValue) bool {
+			return step.Values[0].(float64) > 35
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Release()
+
+	var first *iterator.StepValue
+	got := make([]interface{}, 0)
+	for it.Next() {
+		step := it.Values()
+		if first == nil {
+			first = step
+		}
+		// The StepValue is reused between steps.
+		if step != first {
+			t.Fatalf("got=%p, want=%p", step, first)
+		}
+		if got, want := len(step.Values), 1; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		got = append(got, step.Values[0])
+	}
+	if want := []interface{}{36.0, 37.0, 38.0, 39.0, 40.0}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if _, err := iterator.NewStepIteratorWithOptions(cols, iterator.WithColumns("missing")); err == nil {
+		t.Fatal("expected an error projecting a missing column")
+	}
+	if _, err := iterator.NewStepIteratorWithOptions(cols,
+		iterator.WithColumns("f2-f64"),
+		iterator.WithFilter(func(*iterator.StepValue) bool { return true }, "f1-i32"),
+	); err == nil {
+		t.Fatal("expected an error filtering on a column that is not iterated")
+	}
+	if _, err := iterator.NewStepIteratorWithOptions(cols, iterator.WithBatchSize(10)); err == nil {
+		t.Fatal("expected an error applying WithBatchSize to a StepIterator")
+	}
+}
+
+func TestStepIteratorFilterColumns(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cols := make([]array.Column, 0, tbl.NumCols())
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		cols = append(cols, *tbl.Column(i))
+	}
+
+	it, err := iterator.NewStepIteratorWithOptions(cols,
+		iterator.WithFilter(func(step *iterator.StepValue) bool {
+			// Only the values of the filter columns are read before the row is kept.
+			if got, want := len(step.Values), 1; got != want {
+				t.Fatalf("got=%d, want=%d", got, want)
+			}
+			return step.Exists[0] && step.Values[0] != nil && step.Values[0].(int32)%10 == 0
+		}, "f1-i32"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Release()
+
+	got := make([][]interface{}, 0)
+	for it.Next() {
+		got = append(got, append([]interface{}(nil), it.Values().Values...))
+	}
+	want := [][]interface{}{{int32(10), 10.0}, {int32(20), 20.0}, {int32(40), 40.0}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+}
+
+func TestStepValuesOutliveTheStep(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cols := make([]array.Column, 0, tbl.NumCols())
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		cols = append(cols, *tbl.Column(i))
+	}
+
+	it, err := iterator.NewStepIteratorWithOptions(cols, iterator.WithNewStepValues())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Release()
+
+	// With WithNewStepValues each step has it's own StepValue,
+	// so the values can be kept after the iterator moves.
+	var steps []*iterator.StepValue
+	var jsonSteps []*iterator.StepValue
+	for i := 0; i < 3 && it.Next(); i++ {
+		steps = append(steps, it.Values())
+		jsonStep, err := it.ValuesJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		jsonSteps = append(jsonSteps, jsonStep)
+	}
+	for i, want := range []int32{1, 2, 3} {
+		if got := steps[i].Values[0]; got != want {
+			t.Fatalf("step %d: got=%v, want=%v", i, got, want)
+		}
+		if got := jsonSteps[i].ValuesJSON[0]; got != want {
+			t.Fatalf("step %d: got=%v, want=%v", i, got, want)
+		}
+	}
+}
+
+func TestNewRecordReaderForColumns(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	// A column with chunk boundaries at 5 instead of 10 and 20.
+	b := array.NewInt64Builder(pool)
+	defer b.Release()
+
+	chunks := make([]array.Interface, 0, 2)
+	for _, n := range []int{5, 25} {
+		for i := 0; i < n; i++ {
+			b.Append(int64(i))
+		}
+		chunk := b.NewArray()
+		defer chunk.Release()
+		chunks = append(chunks, chunk)
+	}
+	chunked := array.NewChunked(arrow.PrimitiveTypes.Int64, chunks)
+	defer chunked.Release()
+
+	other := array.NewColumn(arrow.Field{Name: "i64", Type: arrow.PrimitiveTypes.Int64}, chunked)
+	defer other.Release()
+
+	cols := []array.Column{*tbl.Column(0), *tbl.Column(1), *other}
+
+	rr, err := iterator.NewRecordReaderForColumns(cols, iterator.WithColumns("i64", "f1-i32"), iterator.WithBatchSize(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rr.Release()
+
+	if got, want := rr.Schema().Field(0).Name, "i64"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	// Records never cross a chunk boundary of any column.
+	sizes := make([]int64, 0)
+	for rr.Next() {
+		rec := rr.Record()
+		if got, want := rec.NumCols(), int64(2); got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		sizes = append(sizes, rec.NumRows())
+	}
+	if want := []int64{5, 5, 8, 2, 8, 2}; !reflect.DeepEqual(sizes, want) {
+		t.Fatalf("got=%v, want=%v", sizes, want)
+	}
+}
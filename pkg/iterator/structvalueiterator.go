@@ -72,6 +72,27 @@ func (vr *StructValueIterator) ValueInterface() interface{} {
 	return vr.fieldIterators
 }
 
+// Field returns the iterator for the field with the given name, positioned on the current value,
+// or nil if there is no such field. The iterator is owned by the StructValueIterator and is replaced
+// when it moves on to another chunk, so it should not be advanced or released by the caller.
+func (vr *StructValueIterator) Field(name string) ValueIterator {
+	for i, fieldName := range vr.fieldNames {
+		if fieldName == name {
+			return vr.FieldAt(i)
+		}
+	}
+	return nil
+}
+
+// FieldAt returns the iterator for the i-th field, positioned on the current value.
+// The same ownership rules as Field apply.
+func (vr *StructValueIterator) FieldAt(i int) ValueIterator {
+	if vr.fieldIterators == nil {
+		return nil
+	}
+	return vr.fieldIterators[i]
+}
+
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
 func (vr *StructValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
@@ -128,7 +149,13 @@ func (vr *StructValueIterator) nextChunk() bool {
 		return false
 	}
 
-	// There was another chunk.
+	vr.useChunk()
+	vr.index = -1
+	return true
+}
+
+// useChunk sets up the field iterators for the current chunk of the chunk iterator.
+func (vr *StructValueIterator) useChunk() {
 	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
 	ref := vr.chunkIterator.Chunk()
 	ref.Retain()
@@ -144,14 +171,37 @@ func (vr *StructValueIterator) nextChunk() bool {
 	}
 
 	vr.ref = ref.(*array.Struct)
-	vr.index = -1
 
 	// Create the field iterators
 	vr.fieldIterators = make([]ValueIterator, vr.ref.NumField())
 	for i := range vr.fieldIterators {
 		vr.fieldIterators[i] = NewInterfaceValueIterator(vr.dataType.Field(i), vr.ref.Field(i))
 	}
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *StructValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
 
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *StructValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	vr.useChunk()
+	for i := range vr.fieldIterators {
+		vr.fieldIterators[i].(RowSeeker).SeekRow(int64(index))
+	}
+	vr.index = index
+	vr.done = false
 	return true
 }
 
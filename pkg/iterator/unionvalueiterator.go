@@ -0,0 +1,280 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/internal/debug"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/object"
+)
+
+// UnionValueIterator iterates over the union elements.
+// Each value is read from the member selected by it's type code, see logical.UnionOf
+// and logical.DenseUnionOf.
+type UnionValueIterator struct {
+	refCount      int64
+	chunkIterator *ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index     int           // current value index
+	ref       *array.Struct // the chunk reference
+	typeCodes *array.Int8   // the type codes of the chunk
+	offsets   *array.Int32  // the value offsets of the chunk, nil for sparse unions
+	done      bool          // there are no more elements for this iterator
+
+	// We need iterators for each member
+	memberIterators []ValueIterator
+	union           logical.Union
+	dataType        arrow.DataType
+}
+
+// NewUnionValueIterator creates a new UnionValueIterator for reading an Arrow Column.
+func NewUnionValueIterator(col *array.Column) *UnionValueIterator {
+	union, ok := logical.UnionFromField(col.Field())
+	if !ok {
+		panic(fmt.Errorf("iterator/union: column %q is not a union", col.Name()))
+	}
+
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewChunkIterator(col)
+	return &UnionValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index: -1,
+		ref:   nil,
+
+		union:    union,
+		dataType: col.DataType(),
+	}
+}
+
+// Union returns the description of the union members.
+func (vr *UnionValueIterator) Union() logical.Union {
+	return vr.union
+}
+
+// TypeCode will return the type code of the current value and a boolean value indicating if the value is actually null.
+func (vr *UnionValueIterator) TypeCode() (int8, bool) {
+	if vr.ref.IsNull(vr.index) {
+		return 0, true
+	}
+	return vr.typeCodes.Value(vr.index), false
+}
+
+// ChildIndex returns the index of the active member or -1 if the value is actually null.
+func (vr *UnionValueIterator) ChildIndex() int {
+	typeCode, null := vr.TypeCode()
+	if null {
+		return -1
+	}
+	return vr.union.ChildIndex(typeCode)
+}
+
+// ValueIterator returns the iterator of the active member positioned on the current value.
+// It will return nil if the value is actually null.
+// The iterator is owned by the UnionValueIterator and must not be advanced or released.
+func (vr *UnionValueIterator) ValueIterator() ValueIterator {
+	i := vr.ChildIndex()
+	if i < 0 {
+		return nil
+	}
+	return vr.memberIterators[i]
+}
+
+// positionMembers moves the member iterators to the current value.
+// The members of a sparse union move in step with it while only
+// the active member of a dense union is moved to the value offset.
+func (vr *UnionValueIterator) positionMembers() {
+	if vr.offsets == nil {
+		for i := range vr.memberIterators {
+			vr.memberIterators[i].Next()
+		}
+		return
+	}
+	i := vr.ChildIndex()
+	if i < 0 {
+		return
+	}
+	if err := vr.memberIterators[i].(RowSeeker).SeekRow(int64(vr.offsets.Value(vr.index))); err != nil {
+		panic(fmt.Errorf("iterator/union: invalid value offset: %w", err))
+	}
+}
+
+// ValueInterface returns the value of the active member as an interface{}.
+func (vr *UnionValueIterator) ValueInterface() interface{} {
+	it := vr.ValueIterator()
+	if it == nil {
+		return nil
+	}
+	return it.ValueInterface()
+}
+
+// ValueObject returns the value of the active member cast to it's Object type.
+// The last return value is false when the member type has no Object type.
+func (vr *UnionValueIterator) ValueObject() (object.Object, bool) {
+	i := vr.ChildIndex()
+	if i < 0 {
+		return object.NewNull(), true
+	}
+	return object.CastToDataType(vr.union.Members[i].Type, vr.memberIterators[i].ValueInterface())
+}
+
+// ValueAsJSON returns the value of the active member as an interface{} in it's JSON representation.
+func (vr *UnionValueIterator) ValueAsJSON() (interface{}, error) {
+	it := vr.ValueIterator()
+	if it == nil {
+		return nil, nil
+	}
+	return it.ValueAsJSON()
+}
+
+func (vr *UnionValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+func (vr *UnionValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Keep moving the chunk up until we get one with data
+	for vr.ref == nil || vr.advanceMemberIterators() {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *UnionValueIterator) advanceMemberIterators() bool {
+	vr.index++
+	if vr.index >= vr.ref.Len() {
+		return true
+	}
+	vr.positionMembers()
+	return false
+}
+
+func (vr *UnionValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	vr.useChunk()
+	vr.index = -1
+	return true
+}
+
+// useChunk sets up the member iterators for the current chunk of the chunk iterator.
+func (vr *UnionValueIterator) useChunk() {
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+	vr.releaseMemberIterators()
+
+	vr.ref = ref.(*array.Struct)
+	vr.typeCodes = vr.ref.Field(0).(*array.Int8)
+	vr.offsets = nil
+	if vr.union.Mode == logical.DenseMode {
+		vr.offsets = vr.ref.Field(1).(*array.Int32)
+	}
+
+	// Create the member iterators
+	vr.memberIterators = make([]ValueIterator, len(vr.union.Members))
+	for i := range vr.memberIterators {
+		child := vr.ref.Field(vr.union.MemberFieldIndex(i))
+		if vr.offsets != nil {
+			// The value offsets are positions in the values of the member list.
+			child = child.(*array.List).ListValues()
+		}
+		vr.memberIterators[i] = NewInterfaceValueIterator(vr.union.Members[i], child)
+	}
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *UnionValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *UnionValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	vr.useChunk()
+	vr.index = index
+	vr.done = false
+	if vr.offsets == nil {
+		for i := range vr.memberIterators {
+			vr.memberIterators[i].(RowSeeker).SeekRow(int64(index))
+		}
+	} else {
+		vr.positionMembers()
+	}
+	return true
+}
+
+func (vr *UnionValueIterator) releaseMemberIterators() {
+	for i := range vr.memberIterators {
+		vr.memberIterators[i].Release()
+	}
+	vr.memberIterators = nil
+}
+
+// Retain keeps a reference to the UnionValueIterator
+func (vr *UnionValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the UnionValueIterator
+func (vr *UnionValueIterator) Release() {
+	debug.Assert(atomic.LoadInt64(&vr.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&vr.refCount, -1) == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+
+		vr.releaseMemberIterators()
+	}
+}
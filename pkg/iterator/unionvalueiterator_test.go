@@ -0,0 +1,177 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iterator_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/iterator"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/object"
+	"github.com/gomem/gomem/pkg/smartbuilder"
+)
+
+func TestUnionValueIterator(t *testing.T) {
+	for _, mode := range []logical.UnionMode{logical.SparseMode, logical.DenseMode} {
+		t.Run(mode.String(), func(t *testing.T) {
+			testUnionValueIterator(t, mode)
+		})
+	}
+}
+
+func testUnionValueIterator(t *testing.T, mode logical.UnionMode) {
+	pool := gomemtest.NewAllocator(t)
+
+	field := logical.UnionField("f1-union", mode, []arrow.Field{
+		{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "str", Type: arrow.BinaryTypes.String},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64},
+	}, []int8{5, 10, 15})
+	schema := arrow.NewSchema([]arrow.Field{field}, nil)
+
+	rb := array.NewRecordBuilder(pool, schema)
+	defer rb.Release()
+
+	sb := smartbuilder.NewSmartBuilder(rb)
+	for _, v := range []interface{}{int64(1), "a", nil, 2.5, 3, object.String("b")} {
+		if err := sb.Append(0, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sb.Append(0, true); err == nil {
+		t.Fatal("expected an error appending a value with no union member")
+	}
+
+	rec := rb.NewRecord()
+	defer rec.Release()
+
+	chunked := array.NewChunked(field.Type, []array.Interface{rec.Column(0)})
+	defer chunked.Release()
+
+	col := array.NewColumn(field, chunked)
+	defer col.Release()
+
+	it := iterator.NewValueIterator(col)
+	defer it.Release()
+
+	unionIt, ok := it.(*iterator.UnionValueIterator)
+	if !ok {
+		t.Fatalf("got=%T, want=*iterator.UnionValueIterator", it)
+	}
+
+	wantValues := []interface{}{int64(1), "a", nil, 2.5, int64(3), "b"}
+	wantObjects := []object.Object{object.Int64(1), object.String("a"), object.NewNull(), object.Float64(2.5), object.Int64(3), object.String("b")}
+	wantCodes := []int8{5, 10, 0, 15, 5, 10}
+	n := 0
+	for unionIt.Next() {
+		if got, want := unionIt.ValueInterface(), wantValues[n]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		jsonValue, err := unionIt.ValueAsJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := jsonValue, wantValues[n]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		obj, ok := unionIt.ValueObject()
+		if !ok {
+			t.Fatalf("could not cast %v to an Object", wantValues[n])
+		}
+		if got, want := obj, wantObjects[n]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%#v, want=%#v", got, want)
+		}
+		code, null := unionIt.TypeCode()
+		if got, want := null, wantValues[n] == nil; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		if got, want := code, wantCodes[n]; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		n++
+	}
+	if got, want := n, len(wantValues); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+
+	// Seeking backwards repositions the member iterators.
+	if err := unionIt.SeekRow(4); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := unionIt.ValueInterface(), wantValues[4]; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if !unionIt.Next() {
+		t.Fatal("expected a value after row 4")
+	}
+	if got, want := unionIt.ValueInterface(), wantValues[5]; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+}
+
+func TestDenseUnionLayout(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	field := logical.UnionField("f1-union", logical.DenseMode, []arrow.Field{
+		{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "str", Type: arrow.BinaryTypes.String},
+	}, nil)
+	union, ok := logical.UnionFromField(field)
+	if !ok {
+		t.Fatal("expected a union field")
+	}
+	if got, want := union.Mode, logical.DenseMode; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := union.Members[1].Type, arrow.BinaryTypes.String; !arrow.TypeEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{field}, nil)
+	rb := array.NewRecordBuilder(pool, schema)
+	defer rb.Release()
+
+	sb := smartbuilder.NewSmartBuilder(rb)
+	for _, v := range []interface{}{int64(1), "a", int64(2), nil, "b"} {
+		if err := sb.Append(0, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rec := rb.NewRecord()
+	defer rec.Release()
+
+	// Each member only holds it's own values and the offsets index into them.
+	arr := rec.Column(0).(*array.Struct)
+	offsets := arr.Field(1).(*array.Int32)
+	for _, tc := range []struct {
+		got  string
+		want string
+	}{
+		{fmt.Sprint(arr.Field(0)), "[0 1 0 (null) 1]"},
+		{fmt.Sprint(offsets.Int32Values()[:3], offsets.Value(4)), "[0 0 1] 1"},
+		{fmt.Sprint(arr.Field(union.MemberFieldIndex(0)).(*array.List).ListValues()), "[1 2]"},
+		{fmt.Sprint(arr.Field(union.MemberFieldIndex(1)).(*array.List).ListValues()), `["a" "b"]`},
+	} {
+		if tc.got != tc.want {
+			t.Fatalf("got=%s, want=%s", tc.got, tc.want)
+		}
+	}
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/apache/arrow/go/arrow/decimal128"
 	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/gomem/gomem/internal/debug"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/metadata"
 )
 
 // ValueIterator is a generic iterator for scanning over values.
@@ -51,6 +53,21 @@ type ValueIterator interface {
 // NewValueIterator creates a new generic ValueIterator.
 func NewValueIterator(column *array.Column) ValueIterator {
 	field := column.Field()
+
+	// Logical types are stored using a physical Arrow type and marked in the field metadata.
+	if metadata.DictionaryTypeMetadataExists(field.Metadata) {
+		return NewDictionaryValueIterator(column)
+	}
+	if logical.IsMap(field) {
+		return NewMapValueIterator(column)
+	}
+	if logical.IsUnion(field) {
+		return NewUnionValueIterator(column)
+	}
+	if ext, ok := logical.ExtensionTypeOf(field); ok {
+		return NewExtensionValueIterator(column, ext)
+	}
+
 	switch field.Type.(type) {
 
 	case *arrow.BooleanType:
@@ -119,6 +136,9 @@ func NewValueIterator(column *array.Column) ValueIterator {
 	case *arrow.Uint8Type:
 		return NewUint8ValueIterator(column)
 
+	case *arrow.BinaryType:
+		return NewBinaryValueIterator(column)
+
 	case *arrow.ListType:
 		return NewListValueIterator(column)
 
@@ -130,6 +150,88 @@ func NewValueIterator(column *array.Column) ValueIterator {
 	}
 }
 
+// NewReverseValueIterator creates a new generic ValueIterator that walks the column from the last row to the first.
+// Only columns of primitive, boolean and string types can be walked in reverse.
+func NewReverseValueIterator(column *array.Column) ValueIterator {
+	field := column.Field()
+
+	if _, ok := logical.ExtensionTypeOf(field); ok || metadata.DictionaryTypeMetadataExists(field.Metadata) || logical.IsMap(field) || logical.IsUnion(field) {
+		panic(fmt.Errorf("dataframe/valueiterator: unhandled reverse field type %T", field.Type))
+	}
+
+	switch field.Type.(type) {
+
+	case *arrow.BooleanType:
+		return NewReverseBooleanValueIterator(column)
+
+	case *arrow.Date32Type:
+		return NewReverseDate32ValueIterator(column)
+
+	case *arrow.Date64Type:
+		return NewReverseDate64ValueIterator(column)
+
+	case *arrow.DayTimeIntervalType:
+		return NewReverseDayTimeIntervalValueIterator(column)
+
+	case *arrow.Decimal128Type:
+		return NewReverseDecimal128ValueIterator(column)
+
+	case *arrow.DurationType:
+		return NewReverseDurationValueIterator(column)
+
+	case *arrow.Float16Type:
+		return NewReverseFloat16ValueIterator(column)
+
+	case *arrow.Float32Type:
+		return NewReverseFloat32ValueIterator(column)
+
+	case *arrow.Float64Type:
+		return NewReverseFloat64ValueIterator(column)
+
+	case *arrow.Int16Type:
+		return NewReverseInt16ValueIterator(column)
+
+	case *arrow.Int32Type:
+		return NewReverseInt32ValueIterator(column)
+
+	case *arrow.Int64Type:
+		return NewReverseInt64ValueIterator(column)
+
+	case *arrow.Int8Type:
+		return NewReverseInt8ValueIterator(column)
+
+	case *arrow.MonthIntervalType:
+		return NewReverseMonthIntervalValueIterator(column)
+
+	case *arrow.StringType:
+		return NewReverseStringValueIterator(column)
+
+	case *arrow.Time32Type:
+		return NewReverseTime32ValueIterator(column)
+
+	case *arrow.Time64Type:
+		return NewReverseTime64ValueIterator(column)
+
+	case *arrow.TimestampType:
+		return NewReverseTimestampValueIterator(column)
+
+	case *arrow.Uint16Type:
+		return NewReverseUint16ValueIterator(column)
+
+	case *arrow.Uint32Type:
+		return NewReverseUint32ValueIterator(column)
+
+	case *arrow.Uint64Type:
+		return NewReverseUint64ValueIterator(column)
+
+	case *arrow.Uint8Type:
+		return NewReverseUint8ValueIterator(column)
+
+	default:
+		panic(fmt.Errorf("dataframe/valueiterator: unhandled reverse field type %T", field.Type))
+	}
+}
+
 func NewInterfaceValueIterator(field arrow.Field, iface array.Interface) ValueIterator {
 	chunk := array.NewChunked(iface.DataType(), []array.Interface{iface})
 	defer chunk.Release()
@@ -150,6 +252,7 @@ type Date32ValueIterator struct {
 	values []arrow.Date32 // current chunk values
 	ref    *array.Date32  // the chunk reference
 	done   bool           // there are no more elements for this iterator
+	valid  []bool         // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
@@ -247,6 +350,67 @@ func (vr *Date32ValueIterator) nextChunk() bool {
 	return true
 }
 
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Date32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Date32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Date32ValueIterator) At(row int64) (value arrow.Date32, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Date32ValueIterator) NextBatch(max int) ([]arrow.Date32, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
 // Retain keeps a reference to the Date32ValueIterator.
 func (vr *Date32ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
@@ -270,26 +434,26 @@ func (vr *Date32ValueIterator) Release() {
 	}
 }
 
-// Date64ValueIterator is an iterator for reading an Arrow Column value by value.
-type Date64ValueIterator struct {
+// ReverseDate32ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseDate32ValueIterator struct {
 	refCount      int64
-	chunkIterator *Date64ChunkIterator
+	chunkIterator *ReverseDate32ChunkIterator
 
 	// Things we need to maintain for the iterator
 	index  int            // current value index
-	values []arrow.Date64 // current chunk values
-	ref    *array.Date64  // the chunk reference
+	values []arrow.Date32 // current chunk values
+	ref    *array.Date32  // the chunk reference
 	done   bool           // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewDate64ValueIterator creates a new Date64ValueIterator for reading an Arrow Column.
-func NewDate64ValueIterator(col *array.Column) *Date64ValueIterator {
+// NewReverseDate32ValueIterator creates a new ReverseDate32ValueIterator for reading an Arrow Column.
+func NewReverseDate32ValueIterator(col *array.Column) *ReverseDate32ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewDate64ChunkIterator(col)
+	chunkIterator := NewReverseDate32ChunkIterator(col)
 
-	return &Date64ValueIterator{
+	return &ReverseDate32ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -301,12 +465,12 @@ func NewDate64ValueIterator(col *array.Column) *Date64ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Date64ValueIterator) Value() (arrow.Date64, bool) {
+func (vr *ReverseDate32ValueIterator) Value() (arrow.Date32, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Date64ValueIterator) ValuePointer() *arrow.Date64 {
+func (vr *ReverseDate32ValueIterator) ValuePointer() *arrow.Date32 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -314,7 +478,7 @@ func (vr *Date64ValueIterator) ValuePointer() *arrow.Date64 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Date64ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseDate32ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -322,29 +486,29 @@ func (vr *Date64ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Date64ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseDate32ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return date64AsJSON(vr.values[vr.index])
+	return date32AsJSON(vr.values[vr.index])
 }
 
-func (vr *Date64ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseDate32ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Date64ValueIterator) Next() bool {
+func (vr *ReverseDate32ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -355,8 +519,8 @@ func (vr *Date64ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Date64ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
+func (vr *ReverseDate32ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
 		return false
@@ -373,17 +537,48 @@ func (vr *Date64ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = len(vr.values) - 1
 	return true
 }
 
-// Retain keeps a reference to the Date64ValueIterator.
-func (vr *Date64ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseDate32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseDate32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseDate32ValueIterator.
+func (vr *ReverseDate32ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Date64ValueIterator.
-func (vr *Date64ValueIterator) Release() {
+// Release removes a reference to the ReverseDate32ValueIterator.
+func (vr *ReverseDate32ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -400,26 +595,27 @@ func (vr *Date64ValueIterator) Release() {
 	}
 }
 
-// DayTimeIntervalValueIterator is an iterator for reading an Arrow Column value by value.
-type DayTimeIntervalValueIterator struct {
+// Date64ValueIterator is an iterator for reading an Arrow Column value by value.
+type Date64ValueIterator struct {
 	refCount      int64
-	chunkIterator *DayTimeIntervalChunkIterator
+	chunkIterator *Date64ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int                     // current value index
-	values []arrow.DayTimeInterval // current chunk values
-	ref    *array.DayTimeInterval  // the chunk reference
-	done   bool                    // there are no more elements for this iterator
+	index  int            // current value index
+	values []arrow.Date64 // current chunk values
+	ref    *array.Date64  // the chunk reference
+	done   bool           // there are no more elements for this iterator
+	valid  []bool         // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewDayTimeIntervalValueIterator creates a new DayTimeIntervalValueIterator for reading an Arrow Column.
-func NewDayTimeIntervalValueIterator(col *array.Column) *DayTimeIntervalValueIterator {
+// NewDate64ValueIterator creates a new Date64ValueIterator for reading an Arrow Column.
+func NewDate64ValueIterator(col *array.Column) *Date64ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewDayTimeIntervalChunkIterator(col)
+	chunkIterator := NewDate64ChunkIterator(col)
 
-	return &DayTimeIntervalValueIterator{
+	return &Date64ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -431,12 +627,12 @@ func NewDayTimeIntervalValueIterator(col *array.Column) *DayTimeIntervalValueIte
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *DayTimeIntervalValueIterator) Value() (arrow.DayTimeInterval, bool) {
+func (vr *Date64ValueIterator) Value() (arrow.Date64, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *DayTimeIntervalValueIterator) ValuePointer() *arrow.DayTimeInterval {
+func (vr *Date64ValueIterator) ValuePointer() *arrow.Date64 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -444,7 +640,7 @@ func (vr *DayTimeIntervalValueIterator) ValuePointer() *arrow.DayTimeInterval {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *DayTimeIntervalValueIterator) ValueInterface() interface{} {
+func (vr *Date64ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -452,20 +648,20 @@ func (vr *DayTimeIntervalValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *DayTimeIntervalValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *Date64ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return dayTimeIntervalAsJSON(vr.values[vr.index])
+	return date64AsJSON(vr.values[vr.index])
 }
 
-func (vr *DayTimeIntervalValueIterator) DataType() arrow.DataType {
+func (vr *Date64ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *DayTimeIntervalValueIterator) Next() bool {
+func (vr *Date64ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -485,7 +681,7 @@ func (vr *DayTimeIntervalValueIterator) Next() bool {
 	return true
 }
 
-func (vr *DayTimeIntervalValueIterator) nextChunk() bool {
+func (vr *Date64ValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -507,13 +703,74 @@ func (vr *DayTimeIntervalValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the DayTimeIntervalValueIterator.
-func (vr *DayTimeIntervalValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Date64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Date64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Date64ValueIterator) At(row int64) (value arrow.Date64, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Date64ValueIterator) NextBatch(max int) ([]arrow.Date64, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Date64ValueIterator.
+func (vr *Date64ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the DayTimeIntervalValueIterator.
-func (vr *DayTimeIntervalValueIterator) Release() {
+// Release removes a reference to the Date64ValueIterator.
+func (vr *Date64ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -530,26 +787,26 @@ func (vr *DayTimeIntervalValueIterator) Release() {
 	}
 }
 
-// Decimal128ValueIterator is an iterator for reading an Arrow Column value by value.
-type Decimal128ValueIterator struct {
+// ReverseDate64ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseDate64ValueIterator struct {
 	refCount      int64
-	chunkIterator *Decimal128ChunkIterator
+	chunkIterator *ReverseDate64ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int               // current value index
-	values []decimal128.Num  // current chunk values
-	ref    *array.Decimal128 // the chunk reference
-	done   bool              // there are no more elements for this iterator
+	index  int            // current value index
+	values []arrow.Date64 // current chunk values
+	ref    *array.Date64  // the chunk reference
+	done   bool           // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewDecimal128ValueIterator creates a new Decimal128ValueIterator for reading an Arrow Column.
-func NewDecimal128ValueIterator(col *array.Column) *Decimal128ValueIterator {
+// NewReverseDate64ValueIterator creates a new ReverseDate64ValueIterator for reading an Arrow Column.
+func NewReverseDate64ValueIterator(col *array.Column) *ReverseDate64ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewDecimal128ChunkIterator(col)
+	chunkIterator := NewReverseDate64ChunkIterator(col)
 
-	return &Decimal128ValueIterator{
+	return &ReverseDate64ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -561,12 +818,12 @@ func NewDecimal128ValueIterator(col *array.Column) *Decimal128ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Decimal128ValueIterator) Value() (decimal128.Num, bool) {
+func (vr *ReverseDate64ValueIterator) Value() (arrow.Date64, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Decimal128ValueIterator) ValuePointer() *decimal128.Num {
+func (vr *ReverseDate64ValueIterator) ValuePointer() *arrow.Date64 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -574,7 +831,7 @@ func (vr *Decimal128ValueIterator) ValuePointer() *decimal128.Num {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Decimal128ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseDate64ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -582,29 +839,29 @@ func (vr *Decimal128ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Decimal128ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseDate64ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return decimal128AsJSON(vr.values[vr.index])
+	return date64AsJSON(vr.values[vr.index])
 }
 
-func (vr *Decimal128ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseDate64ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Decimal128ValueIterator) Next() bool {
+func (vr *ReverseDate64ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -615,8 +872,8 @@ func (vr *Decimal128ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Decimal128ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
+func (vr *ReverseDate64ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
 		return false
@@ -633,24 +890,3585 @@ func (vr *Decimal128ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = len(vr.values) - 1
 	return true
 }
 
-// Retain keeps a reference to the Decimal128ValueIterator.
-func (vr *Decimal128ValueIterator) Retain() {
-	atomic.AddInt64(&vr.refCount, 1)
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseDate64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
 }
 
-// Release removes a reference to the Decimal128ValueIterator.
-func (vr *Decimal128ValueIterator) Release() {
-	refs := atomic.AddInt64(&vr.refCount, -1)
-	debug.Assert(refs >= 0, "too many releases")
-	if refs == 0 {
-		if vr.chunkIterator != nil {
-			vr.chunkIterator.Release()
-			vr.chunkIterator = nil
-		}
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseDate64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseDate64ValueIterator.
+func (vr *ReverseDate64ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseDate64ValueIterator.
+func (vr *ReverseDate64ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// DayTimeIntervalValueIterator is an iterator for reading an Arrow Column value by value.
+type DayTimeIntervalValueIterator struct {
+	refCount      int64
+	chunkIterator *DayTimeIntervalChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int                     // current value index
+	values []arrow.DayTimeInterval // current chunk values
+	ref    *array.DayTimeInterval  // the chunk reference
+	done   bool                    // there are no more elements for this iterator
+	valid  []bool                  // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewDayTimeIntervalValueIterator creates a new DayTimeIntervalValueIterator for reading an Arrow Column.
+func NewDayTimeIntervalValueIterator(col *array.Column) *DayTimeIntervalValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewDayTimeIntervalChunkIterator(col)
+
+	return &DayTimeIntervalValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *DayTimeIntervalValueIterator) Value() (arrow.DayTimeInterval, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *DayTimeIntervalValueIterator) ValuePointer() *arrow.DayTimeInterval {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *DayTimeIntervalValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *DayTimeIntervalValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return dayTimeIntervalAsJSON(vr.values[vr.index])
+}
+
+func (vr *DayTimeIntervalValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *DayTimeIntervalValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *DayTimeIntervalValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *DayTimeIntervalValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *DayTimeIntervalValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *DayTimeIntervalValueIterator) At(row int64) (value arrow.DayTimeInterval, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *DayTimeIntervalValueIterator) NextBatch(max int) ([]arrow.DayTimeInterval, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the DayTimeIntervalValueIterator.
+func (vr *DayTimeIntervalValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the DayTimeIntervalValueIterator.
+func (vr *DayTimeIntervalValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseDayTimeIntervalValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseDayTimeIntervalValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseDayTimeIntervalChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int                     // current value index
+	values []arrow.DayTimeInterval // current chunk values
+	ref    *array.DayTimeInterval  // the chunk reference
+	done   bool                    // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseDayTimeIntervalValueIterator creates a new ReverseDayTimeIntervalValueIterator for reading an Arrow Column.
+func NewReverseDayTimeIntervalValueIterator(col *array.Column) *ReverseDayTimeIntervalValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseDayTimeIntervalChunkIterator(col)
+
+	return &ReverseDayTimeIntervalValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseDayTimeIntervalValueIterator) Value() (arrow.DayTimeInterval, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseDayTimeIntervalValueIterator) ValuePointer() *arrow.DayTimeInterval {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseDayTimeIntervalValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseDayTimeIntervalValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return dayTimeIntervalAsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseDayTimeIntervalValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseDayTimeIntervalValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseDayTimeIntervalValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseDayTimeIntervalValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseDayTimeIntervalValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseDayTimeIntervalValueIterator.
+func (vr *ReverseDayTimeIntervalValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseDayTimeIntervalValueIterator.
+func (vr *ReverseDayTimeIntervalValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// Decimal128ValueIterator is an iterator for reading an Arrow Column value by value.
+type Decimal128ValueIterator struct {
+	refCount      int64
+	chunkIterator *Decimal128ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int               // current value index
+	values []decimal128.Num  // current chunk values
+	ref    *array.Decimal128 // the chunk reference
+	done   bool              // there are no more elements for this iterator
+	valid  []bool            // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewDecimal128ValueIterator creates a new Decimal128ValueIterator for reading an Arrow Column.
+func NewDecimal128ValueIterator(col *array.Column) *Decimal128ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewDecimal128ChunkIterator(col)
+
+	return &Decimal128ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *Decimal128ValueIterator) Value() (decimal128.Num, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *Decimal128ValueIterator) ValuePointer() *decimal128.Num {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *Decimal128ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *Decimal128ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return decimal128AsJSON(vr.values[vr.index])
+}
+
+func (vr *Decimal128ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *Decimal128ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *Decimal128ValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Decimal128ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Decimal128ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Decimal128ValueIterator) At(row int64) (value decimal128.Num, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Decimal128ValueIterator) NextBatch(max int) ([]decimal128.Num, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Decimal128ValueIterator.
+func (vr *Decimal128ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the Decimal128ValueIterator.
+func (vr *Decimal128ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseDecimal128ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseDecimal128ValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseDecimal128ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int               // current value index
+	values []decimal128.Num  // current chunk values
+	ref    *array.Decimal128 // the chunk reference
+	done   bool              // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseDecimal128ValueIterator creates a new ReverseDecimal128ValueIterator for reading an Arrow Column.
+func NewReverseDecimal128ValueIterator(col *array.Column) *ReverseDecimal128ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseDecimal128ChunkIterator(col)
+
+	return &ReverseDecimal128ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseDecimal128ValueIterator) Value() (decimal128.Num, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseDecimal128ValueIterator) ValuePointer() *decimal128.Num {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseDecimal128ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseDecimal128ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return decimal128AsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseDecimal128ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseDecimal128ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseDecimal128ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseDecimal128ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseDecimal128ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseDecimal128ValueIterator.
+func (vr *ReverseDecimal128ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseDecimal128ValueIterator.
+func (vr *ReverseDecimal128ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// DurationValueIterator is an iterator for reading an Arrow Column value by value.
+type DurationValueIterator struct {
+	refCount      int64
+	chunkIterator *DurationChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int              // current value index
+	values []arrow.Duration // current chunk values
+	ref    *array.Duration  // the chunk reference
+	done   bool             // there are no more elements for this iterator
+	valid  []bool           // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewDurationValueIterator creates a new DurationValueIterator for reading an Arrow Column.
+func NewDurationValueIterator(col *array.Column) *DurationValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewDurationChunkIterator(col)
+
+	return &DurationValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *DurationValueIterator) Value() (arrow.Duration, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *DurationValueIterator) ValuePointer() *arrow.Duration {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *DurationValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *DurationValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return durationAsJSON(vr.values[vr.index])
+}
+
+func (vr *DurationValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *DurationValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *DurationValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *DurationValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *DurationValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *DurationValueIterator) At(row int64) (value arrow.Duration, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *DurationValueIterator) NextBatch(max int) ([]arrow.Duration, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the DurationValueIterator.
+func (vr *DurationValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the DurationValueIterator.
+func (vr *DurationValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseDurationValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseDurationValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseDurationChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int              // current value index
+	values []arrow.Duration // current chunk values
+	ref    *array.Duration  // the chunk reference
+	done   bool             // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseDurationValueIterator creates a new ReverseDurationValueIterator for reading an Arrow Column.
+func NewReverseDurationValueIterator(col *array.Column) *ReverseDurationValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseDurationChunkIterator(col)
+
+	return &ReverseDurationValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseDurationValueIterator) Value() (arrow.Duration, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseDurationValueIterator) ValuePointer() *arrow.Duration {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseDurationValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseDurationValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return durationAsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseDurationValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseDurationValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseDurationValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseDurationValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseDurationValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseDurationValueIterator.
+func (vr *ReverseDurationValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseDurationValueIterator.
+func (vr *ReverseDurationValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// Float16ValueIterator is an iterator for reading an Arrow Column value by value.
+type Float16ValueIterator struct {
+	refCount      int64
+	chunkIterator *Float16ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int            // current value index
+	values []float16.Num  // current chunk values
+	ref    *array.Float16 // the chunk reference
+	done   bool           // there are no more elements for this iterator
+	valid  []bool         // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewFloat16ValueIterator creates a new Float16ValueIterator for reading an Arrow Column.
+func NewFloat16ValueIterator(col *array.Column) *Float16ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewFloat16ChunkIterator(col)
+
+	return &Float16ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *Float16ValueIterator) Value() (float16.Num, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *Float16ValueIterator) ValuePointer() *float16.Num {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *Float16ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *Float16ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return float16AsJSON(vr.values[vr.index])
+}
+
+func (vr *Float16ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *Float16ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *Float16ValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Float16ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Float16ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Float16ValueIterator) At(row int64) (value float16.Num, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Float16ValueIterator) NextBatch(max int) ([]float16.Num, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Float16ValueIterator.
+func (vr *Float16ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the Float16ValueIterator.
+func (vr *Float16ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseFloat16ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseFloat16ValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseFloat16ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int            // current value index
+	values []float16.Num  // current chunk values
+	ref    *array.Float16 // the chunk reference
+	done   bool           // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseFloat16ValueIterator creates a new ReverseFloat16ValueIterator for reading an Arrow Column.
+func NewReverseFloat16ValueIterator(col *array.Column) *ReverseFloat16ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseFloat16ChunkIterator(col)
+
+	return &ReverseFloat16ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseFloat16ValueIterator) Value() (float16.Num, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseFloat16ValueIterator) ValuePointer() *float16.Num {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseFloat16ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseFloat16ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return float16AsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseFloat16ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseFloat16ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseFloat16ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseFloat16ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseFloat16ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseFloat16ValueIterator.
+func (vr *ReverseFloat16ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseFloat16ValueIterator.
+func (vr *ReverseFloat16ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// Float32ValueIterator is an iterator for reading an Arrow Column value by value.
+type Float32ValueIterator struct {
+	refCount      int64
+	chunkIterator *Float32ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int            // current value index
+	values []float32      // current chunk values
+	ref    *array.Float32 // the chunk reference
+	done   bool           // there are no more elements for this iterator
+	valid  []bool         // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewFloat32ValueIterator creates a new Float32ValueIterator for reading an Arrow Column.
+func NewFloat32ValueIterator(col *array.Column) *Float32ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewFloat32ChunkIterator(col)
+
+	return &Float32ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *Float32ValueIterator) Value() (float32, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *Float32ValueIterator) ValuePointer() *float32 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *Float32ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *Float32ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return float32AsJSON(vr.values[vr.index])
+}
+
+func (vr *Float32ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *Float32ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *Float32ValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Float32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Float32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Float32ValueIterator) At(row int64) (value float32, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Float32ValueIterator) NextBatch(max int) ([]float32, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Float32ValueIterator.
+func (vr *Float32ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the Float32ValueIterator.
+func (vr *Float32ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseFloat32ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseFloat32ValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseFloat32ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int            // current value index
+	values []float32      // current chunk values
+	ref    *array.Float32 // the chunk reference
+	done   bool           // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseFloat32ValueIterator creates a new ReverseFloat32ValueIterator for reading an Arrow Column.
+func NewReverseFloat32ValueIterator(col *array.Column) *ReverseFloat32ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseFloat32ChunkIterator(col)
+
+	return &ReverseFloat32ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseFloat32ValueIterator) Value() (float32, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseFloat32ValueIterator) ValuePointer() *float32 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseFloat32ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseFloat32ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return float32AsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseFloat32ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseFloat32ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseFloat32ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseFloat32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseFloat32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseFloat32ValueIterator.
+func (vr *ReverseFloat32ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseFloat32ValueIterator.
+func (vr *ReverseFloat32ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// Float64ValueIterator is an iterator for reading an Arrow Column value by value.
+type Float64ValueIterator struct {
+	refCount      int64
+	chunkIterator *Float64ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int            // current value index
+	values []float64      // current chunk values
+	ref    *array.Float64 // the chunk reference
+	done   bool           // there are no more elements for this iterator
+	valid  []bool         // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewFloat64ValueIterator creates a new Float64ValueIterator for reading an Arrow Column.
+func NewFloat64ValueIterator(col *array.Column) *Float64ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewFloat64ChunkIterator(col)
+
+	return &Float64ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *Float64ValueIterator) Value() (float64, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *Float64ValueIterator) ValuePointer() *float64 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *Float64ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *Float64ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return float64AsJSON(vr.values[vr.index])
+}
+
+func (vr *Float64ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *Float64ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *Float64ValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Float64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Float64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Float64ValueIterator) At(row int64) (value float64, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Float64ValueIterator) NextBatch(max int) ([]float64, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Float64ValueIterator.
+func (vr *Float64ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the Float64ValueIterator.
+func (vr *Float64ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseFloat64ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseFloat64ValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseFloat64ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int            // current value index
+	values []float64      // current chunk values
+	ref    *array.Float64 // the chunk reference
+	done   bool           // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseFloat64ValueIterator creates a new ReverseFloat64ValueIterator for reading an Arrow Column.
+func NewReverseFloat64ValueIterator(col *array.Column) *ReverseFloat64ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseFloat64ChunkIterator(col)
+
+	return &ReverseFloat64ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseFloat64ValueIterator) Value() (float64, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseFloat64ValueIterator) ValuePointer() *float64 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseFloat64ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseFloat64ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return float64AsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseFloat64ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseFloat64ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseFloat64ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseFloat64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseFloat64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseFloat64ValueIterator.
+func (vr *ReverseFloat64ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseFloat64ValueIterator.
+func (vr *ReverseFloat64ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// Int16ValueIterator is an iterator for reading an Arrow Column value by value.
+type Int16ValueIterator struct {
+	refCount      int64
+	chunkIterator *Int16ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int          // current value index
+	values []int16      // current chunk values
+	ref    *array.Int16 // the chunk reference
+	done   bool         // there are no more elements for this iterator
+	valid  []bool       // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewInt16ValueIterator creates a new Int16ValueIterator for reading an Arrow Column.
+func NewInt16ValueIterator(col *array.Column) *Int16ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewInt16ChunkIterator(col)
+
+	return &Int16ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *Int16ValueIterator) Value() (int16, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *Int16ValueIterator) ValuePointer() *int16 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *Int16ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *Int16ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return int16AsJSON(vr.values[vr.index])
+}
+
+func (vr *Int16ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *Int16ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *Int16ValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Int16ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Int16ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Int16ValueIterator) At(row int64) (value int16, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Int16ValueIterator) NextBatch(max int) ([]int16, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Int16ValueIterator.
+func (vr *Int16ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the Int16ValueIterator.
+func (vr *Int16ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseInt16ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseInt16ValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseInt16ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int          // current value index
+	values []int16      // current chunk values
+	ref    *array.Int16 // the chunk reference
+	done   bool         // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseInt16ValueIterator creates a new ReverseInt16ValueIterator for reading an Arrow Column.
+func NewReverseInt16ValueIterator(col *array.Column) *ReverseInt16ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseInt16ChunkIterator(col)
+
+	return &ReverseInt16ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseInt16ValueIterator) Value() (int16, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseInt16ValueIterator) ValuePointer() *int16 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseInt16ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseInt16ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return int16AsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseInt16ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseInt16ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseInt16ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseInt16ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseInt16ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseInt16ValueIterator.
+func (vr *ReverseInt16ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseInt16ValueIterator.
+func (vr *ReverseInt16ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// Int32ValueIterator is an iterator for reading an Arrow Column value by value.
+type Int32ValueIterator struct {
+	refCount      int64
+	chunkIterator *Int32ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int          // current value index
+	values []int32      // current chunk values
+	ref    *array.Int32 // the chunk reference
+	done   bool         // there are no more elements for this iterator
+	valid  []bool       // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewInt32ValueIterator creates a new Int32ValueIterator for reading an Arrow Column.
+func NewInt32ValueIterator(col *array.Column) *Int32ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewInt32ChunkIterator(col)
+
+	return &Int32ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *Int32ValueIterator) Value() (int32, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *Int32ValueIterator) ValuePointer() *int32 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *Int32ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *Int32ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return int32AsJSON(vr.values[vr.index])
+}
+
+func (vr *Int32ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *Int32ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *Int32ValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Int32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Int32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Int32ValueIterator) At(row int64) (value int32, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Int32ValueIterator) NextBatch(max int) ([]int32, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Int32ValueIterator.
+func (vr *Int32ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the Int32ValueIterator.
+func (vr *Int32ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseInt32ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseInt32ValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseInt32ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int          // current value index
+	values []int32      // current chunk values
+	ref    *array.Int32 // the chunk reference
+	done   bool         // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseInt32ValueIterator creates a new ReverseInt32ValueIterator for reading an Arrow Column.
+func NewReverseInt32ValueIterator(col *array.Column) *ReverseInt32ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseInt32ChunkIterator(col)
+
+	return &ReverseInt32ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseInt32ValueIterator) Value() (int32, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseInt32ValueIterator) ValuePointer() *int32 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseInt32ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseInt32ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return int32AsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseInt32ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseInt32ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseInt32ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseInt32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseInt32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseInt32ValueIterator.
+func (vr *ReverseInt32ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseInt32ValueIterator.
+func (vr *ReverseInt32ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// Int64ValueIterator is an iterator for reading an Arrow Column value by value.
+type Int64ValueIterator struct {
+	refCount      int64
+	chunkIterator *Int64ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int          // current value index
+	values []int64      // current chunk values
+	ref    *array.Int64 // the chunk reference
+	done   bool         // there are no more elements for this iterator
+	valid  []bool       // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewInt64ValueIterator creates a new Int64ValueIterator for reading an Arrow Column.
+func NewInt64ValueIterator(col *array.Column) *Int64ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewInt64ChunkIterator(col)
+
+	return &Int64ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *Int64ValueIterator) Value() (int64, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *Int64ValueIterator) ValuePointer() *int64 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *Int64ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *Int64ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return int64AsJSON(vr.values[vr.index])
+}
+
+func (vr *Int64ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *Int64ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *Int64ValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Int64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Int64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Int64ValueIterator) At(row int64) (value int64, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Int64ValueIterator) NextBatch(max int) ([]int64, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Int64ValueIterator.
+func (vr *Int64ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the Int64ValueIterator.
+func (vr *Int64ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseInt64ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseInt64ValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseInt64ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int          // current value index
+	values []int64      // current chunk values
+	ref    *array.Int64 // the chunk reference
+	done   bool         // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseInt64ValueIterator creates a new ReverseInt64ValueIterator for reading an Arrow Column.
+func NewReverseInt64ValueIterator(col *array.Column) *ReverseInt64ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseInt64ChunkIterator(col)
+
+	return &ReverseInt64ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseInt64ValueIterator) Value() (int64, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseInt64ValueIterator) ValuePointer() *int64 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseInt64ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseInt64ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return int64AsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseInt64ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseInt64ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseInt64ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseInt64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseInt64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseInt64ValueIterator.
+func (vr *ReverseInt64ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseInt64ValueIterator.
+func (vr *ReverseInt64ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// Int8ValueIterator is an iterator for reading an Arrow Column value by value.
+type Int8ValueIterator struct {
+	refCount      int64
+	chunkIterator *Int8ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int         // current value index
+	values []int8      // current chunk values
+	ref    *array.Int8 // the chunk reference
+	done   bool        // there are no more elements for this iterator
+	valid  []bool      // validity buffer reused by NextBatch
+
+	dataType arrow.DataType
+}
+
+// NewInt8ValueIterator creates a new Int8ValueIterator for reading an Arrow Column.
+func NewInt8ValueIterator(col *array.Column) *Int8ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewInt8ChunkIterator(col)
+
+	return &Int8ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *Int8ValueIterator) Value() (int8, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *Int8ValueIterator) ValuePointer() *int8 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *Int8ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *Int8ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return int8AsJSON(vr.values[vr.index])
+}
+
+func (vr *Int8ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the next value. This will return false
+// when there are no more values.
+func (vr *Int8ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index up
+	vr.index++
+
+	// Keep moving the chunk up until we get one with data
+	for vr.values == nil || vr.index >= len(vr.values) {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *Int8ValueIterator) nextChunk() bool {
+	// Advance the chunk until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = 0
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Int8ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Int8ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Int8ValueIterator) At(row int64) (value int8, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Int8ValueIterator) NextBatch(max int) ([]int8, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Int8ValueIterator.
+func (vr *Int8ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the Int8ValueIterator.
+func (vr *Int8ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
+
+		if vr.ref != nil {
+			vr.ref.Release()
+			vr.ref = nil
+		}
+		vr.values = nil
+	}
+}
+
+// ReverseInt8ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseInt8ValueIterator struct {
+	refCount      int64
+	chunkIterator *ReverseInt8ChunkIterator
+
+	// Things we need to maintain for the iterator
+	index  int         // current value index
+	values []int8      // current chunk values
+	ref    *array.Int8 // the chunk reference
+	done   bool        // there are no more elements for this iterator
+
+	dataType arrow.DataType
+}
+
+// NewReverseInt8ValueIterator creates a new ReverseInt8ValueIterator for reading an Arrow Column.
+func NewReverseInt8ValueIterator(col *array.Column) *ReverseInt8ValueIterator {
+	// We need a ChunkIterator to read the chunks
+	chunkIterator := NewReverseInt8ChunkIterator(col)
+
+	return &ReverseInt8ValueIterator{
+		refCount:      1,
+		chunkIterator: chunkIterator,
+
+		index:  0,
+		values: nil,
+
+		dataType: col.DataType(),
+	}
+}
+
+// Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
+func (vr *ReverseInt8ValueIterator) Value() (int8, bool) {
+	return vr.values[vr.index], vr.ref.IsNull(vr.index)
+}
+
+// ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
+func (vr *ReverseInt8ValueIterator) ValuePointer() *int8 {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return &vr.values[vr.index]
+}
+
+// ValueInterface returns the current value as an interface{}.
+func (vr *ReverseInt8ValueIterator) ValueInterface() interface{} {
+	if vr.ref.IsNull(vr.index) {
+		return nil
+	}
+	return vr.values[vr.index]
+}
+
+// ValueAsJSON returns the current value as an interface{} in it's JSON representation.
+func (vr *ReverseInt8ValueIterator) ValueAsJSON() (interface{}, error) {
+	if vr.ref.IsNull(vr.index) {
+		return nil, nil
+	}
+	return int8AsJSON(vr.values[vr.index])
+}
+
+func (vr *ReverseInt8ValueIterator) DataType() arrow.DataType {
+	return vr.dataType
+}
+
+// Next moves the iterator to the previous value. This will return false
+// when there are no more values.
+func (vr *ReverseInt8ValueIterator) Next() bool {
+	if vr.done {
+		return false
+	}
+
+	// Move the index down
+	vr.index--
+
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
+		if !vr.nextChunk() {
+			// There were no more chunks with data in them
+			vr.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+func (vr *ReverseInt8ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseInt8ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseInt8ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseInt8ValueIterator.
+func (vr *ReverseInt8ValueIterator) Retain() {
+	atomic.AddInt64(&vr.refCount, 1)
+}
+
+// Release removes a reference to the ReverseInt8ValueIterator.
+func (vr *ReverseInt8ValueIterator) Release() {
+	refs := atomic.AddInt64(&vr.refCount, -1)
+	debug.Assert(refs >= 0, "too many releases")
+	if refs == 0 {
+		if vr.chunkIterator != nil {
+			vr.chunkIterator.Release()
+			vr.chunkIterator = nil
+		}
 
 		if vr.ref != nil {
 			vr.ref.Release()
@@ -660,26 +4478,27 @@ func (vr *Decimal128ValueIterator) Release() {
 	}
 }
 
-// DurationValueIterator is an iterator for reading an Arrow Column value by value.
-type DurationValueIterator struct {
+// MonthIntervalValueIterator is an iterator for reading an Arrow Column value by value.
+type MonthIntervalValueIterator struct {
 	refCount      int64
-	chunkIterator *DurationChunkIterator
+	chunkIterator *MonthIntervalChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int              // current value index
-	values []arrow.Duration // current chunk values
-	ref    *array.Duration  // the chunk reference
-	done   bool             // there are no more elements for this iterator
+	index  int                   // current value index
+	values []arrow.MonthInterval // current chunk values
+	ref    *array.MonthInterval  // the chunk reference
+	done   bool                  // there are no more elements for this iterator
+	valid  []bool                // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewDurationValueIterator creates a new DurationValueIterator for reading an Arrow Column.
-func NewDurationValueIterator(col *array.Column) *DurationValueIterator {
+// NewMonthIntervalValueIterator creates a new MonthIntervalValueIterator for reading an Arrow Column.
+func NewMonthIntervalValueIterator(col *array.Column) *MonthIntervalValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewDurationChunkIterator(col)
+	chunkIterator := NewMonthIntervalChunkIterator(col)
 
-	return &DurationValueIterator{
+	return &MonthIntervalValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -691,12 +4510,12 @@ func NewDurationValueIterator(col *array.Column) *DurationValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *DurationValueIterator) Value() (arrow.Duration, bool) {
+func (vr *MonthIntervalValueIterator) Value() (arrow.MonthInterval, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *DurationValueIterator) ValuePointer() *arrow.Duration {
+func (vr *MonthIntervalValueIterator) ValuePointer() *arrow.MonthInterval {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -704,7 +4523,7 @@ func (vr *DurationValueIterator) ValuePointer() *arrow.Duration {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *DurationValueIterator) ValueInterface() interface{} {
+func (vr *MonthIntervalValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -712,20 +4531,20 @@ func (vr *DurationValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *DurationValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *MonthIntervalValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return durationAsJSON(vr.values[vr.index])
+	return monthIntervalAsJSON(vr.values[vr.index])
 }
 
-func (vr *DurationValueIterator) DataType() arrow.DataType {
+func (vr *MonthIntervalValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *DurationValueIterator) Next() bool {
+func (vr *MonthIntervalValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -745,7 +4564,7 @@ func (vr *DurationValueIterator) Next() bool {
 	return true
 }
 
-func (vr *DurationValueIterator) nextChunk() bool {
+func (vr *MonthIntervalValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -767,13 +4586,74 @@ func (vr *DurationValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the DurationValueIterator.
-func (vr *DurationValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *MonthIntervalValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *MonthIntervalValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *MonthIntervalValueIterator) At(row int64) (value arrow.MonthInterval, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *MonthIntervalValueIterator) NextBatch(max int) ([]arrow.MonthInterval, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the MonthIntervalValueIterator.
+func (vr *MonthIntervalValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the DurationValueIterator.
-func (vr *DurationValueIterator) Release() {
+// Release removes a reference to the MonthIntervalValueIterator.
+func (vr *MonthIntervalValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -790,26 +4670,26 @@ func (vr *DurationValueIterator) Release() {
 	}
 }
 
-// Float16ValueIterator is an iterator for reading an Arrow Column value by value.
-type Float16ValueIterator struct {
+// ReverseMonthIntervalValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseMonthIntervalValueIterator struct {
 	refCount      int64
-	chunkIterator *Float16ChunkIterator
+	chunkIterator *ReverseMonthIntervalChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int            // current value index
-	values []float16.Num  // current chunk values
-	ref    *array.Float16 // the chunk reference
-	done   bool           // there are no more elements for this iterator
+	index  int                   // current value index
+	values []arrow.MonthInterval // current chunk values
+	ref    *array.MonthInterval  // the chunk reference
+	done   bool                  // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewFloat16ValueIterator creates a new Float16ValueIterator for reading an Arrow Column.
-func NewFloat16ValueIterator(col *array.Column) *Float16ValueIterator {
+// NewReverseMonthIntervalValueIterator creates a new ReverseMonthIntervalValueIterator for reading an Arrow Column.
+func NewReverseMonthIntervalValueIterator(col *array.Column) *ReverseMonthIntervalValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewFloat16ChunkIterator(col)
+	chunkIterator := NewReverseMonthIntervalChunkIterator(col)
 
-	return &Float16ValueIterator{
+	return &ReverseMonthIntervalValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -821,12 +4701,12 @@ func NewFloat16ValueIterator(col *array.Column) *Float16ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Float16ValueIterator) Value() (float16.Num, bool) {
+func (vr *ReverseMonthIntervalValueIterator) Value() (arrow.MonthInterval, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Float16ValueIterator) ValuePointer() *float16.Num {
+func (vr *ReverseMonthIntervalValueIterator) ValuePointer() *arrow.MonthInterval {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -834,7 +4714,7 @@ func (vr *Float16ValueIterator) ValuePointer() *float16.Num {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Float16ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseMonthIntervalValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -842,29 +4722,29 @@ func (vr *Float16ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Float16ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseMonthIntervalValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return float16AsJSON(vr.values[vr.index])
+	return monthIntervalAsJSON(vr.values[vr.index])
 }
 
-func (vr *Float16ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseMonthIntervalValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Float16ValueIterator) Next() bool {
+func (vr *ReverseMonthIntervalValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -875,8 +4755,8 @@ func (vr *Float16ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Float16ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
+func (vr *ReverseMonthIntervalValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
 		return false
@@ -893,17 +4773,48 @@ func (vr *Float16ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = len(vr.values) - 1
 	return true
 }
 
-// Retain keeps a reference to the Float16ValueIterator.
-func (vr *Float16ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseMonthIntervalValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseMonthIntervalValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseMonthIntervalValueIterator.
+func (vr *ReverseMonthIntervalValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Float16ValueIterator.
-func (vr *Float16ValueIterator) Release() {
+// Release removes a reference to the ReverseMonthIntervalValueIterator.
+func (vr *ReverseMonthIntervalValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -920,26 +4831,27 @@ func (vr *Float16ValueIterator) Release() {
 	}
 }
 
-// Float32ValueIterator is an iterator for reading an Arrow Column value by value.
-type Float32ValueIterator struct {
+// Time32ValueIterator is an iterator for reading an Arrow Column value by value.
+type Time32ValueIterator struct {
 	refCount      int64
-	chunkIterator *Float32ChunkIterator
+	chunkIterator *Time32ChunkIterator
 
 	// Things we need to maintain for the iterator
 	index  int            // current value index
-	values []float32      // current chunk values
-	ref    *array.Float32 // the chunk reference
+	values []arrow.Time32 // current chunk values
+	ref    *array.Time32  // the chunk reference
 	done   bool           // there are no more elements for this iterator
+	valid  []bool         // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewFloat32ValueIterator creates a new Float32ValueIterator for reading an Arrow Column.
-func NewFloat32ValueIterator(col *array.Column) *Float32ValueIterator {
+// NewTime32ValueIterator creates a new Time32ValueIterator for reading an Arrow Column.
+func NewTime32ValueIterator(col *array.Column) *Time32ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewFloat32ChunkIterator(col)
+	chunkIterator := NewTime32ChunkIterator(col)
 
-	return &Float32ValueIterator{
+	return &Time32ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -951,12 +4863,12 @@ func NewFloat32ValueIterator(col *array.Column) *Float32ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Float32ValueIterator) Value() (float32, bool) {
+func (vr *Time32ValueIterator) Value() (arrow.Time32, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Float32ValueIterator) ValuePointer() *float32 {
+func (vr *Time32ValueIterator) ValuePointer() *arrow.Time32 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -964,7 +4876,7 @@ func (vr *Float32ValueIterator) ValuePointer() *float32 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Float32ValueIterator) ValueInterface() interface{} {
+func (vr *Time32ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -972,20 +4884,20 @@ func (vr *Float32ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Float32ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *Time32ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return float32AsJSON(vr.values[vr.index])
+	return time32AsJSON(vr.values[vr.index])
 }
 
-func (vr *Float32ValueIterator) DataType() arrow.DataType {
+func (vr *Time32ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *Float32ValueIterator) Next() bool {
+func (vr *Time32ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -1005,7 +4917,7 @@ func (vr *Float32ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Float32ValueIterator) nextChunk() bool {
+func (vr *Time32ValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -1027,13 +4939,74 @@ func (vr *Float32ValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the Float32ValueIterator.
-func (vr *Float32ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Time32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Time32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Time32ValueIterator) At(row int64) (value arrow.Time32, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Time32ValueIterator) NextBatch(max int) ([]arrow.Time32, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Time32ValueIterator.
+func (vr *Time32ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Float32ValueIterator.
-func (vr *Float32ValueIterator) Release() {
+// Release removes a reference to the Time32ValueIterator.
+func (vr *Time32ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -1050,26 +5023,26 @@ func (vr *Float32ValueIterator) Release() {
 	}
 }
 
-// Float64ValueIterator is an iterator for reading an Arrow Column value by value.
-type Float64ValueIterator struct {
+// ReverseTime32ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseTime32ValueIterator struct {
 	refCount      int64
-	chunkIterator *Float64ChunkIterator
+	chunkIterator *ReverseTime32ChunkIterator
 
 	// Things we need to maintain for the iterator
 	index  int            // current value index
-	values []float64      // current chunk values
-	ref    *array.Float64 // the chunk reference
+	values []arrow.Time32 // current chunk values
+	ref    *array.Time32  // the chunk reference
 	done   bool           // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewFloat64ValueIterator creates a new Float64ValueIterator for reading an Arrow Column.
-func NewFloat64ValueIterator(col *array.Column) *Float64ValueIterator {
+// NewReverseTime32ValueIterator creates a new ReverseTime32ValueIterator for reading an Arrow Column.
+func NewReverseTime32ValueIterator(col *array.Column) *ReverseTime32ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewFloat64ChunkIterator(col)
+	chunkIterator := NewReverseTime32ChunkIterator(col)
 
-	return &Float64ValueIterator{
+	return &ReverseTime32ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -1081,12 +5054,12 @@ func NewFloat64ValueIterator(col *array.Column) *Float64ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Float64ValueIterator) Value() (float64, bool) {
+func (vr *ReverseTime32ValueIterator) Value() (arrow.Time32, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Float64ValueIterator) ValuePointer() *float64 {
+func (vr *ReverseTime32ValueIterator) ValuePointer() *arrow.Time32 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1094,7 +5067,7 @@ func (vr *Float64ValueIterator) ValuePointer() *float64 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Float64ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseTime32ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1102,29 +5075,29 @@ func (vr *Float64ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Float64ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseTime32ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return float64AsJSON(vr.values[vr.index])
+	return time32AsJSON(vr.values[vr.index])
 }
 
-func (vr *Float64ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseTime32ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Float64ValueIterator) Next() bool {
+func (vr *ReverseTime32ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -1135,8 +5108,8 @@ func (vr *Float64ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Float64ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
+func (vr *ReverseTime32ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
 		return false
@@ -1153,17 +5126,48 @@ func (vr *Float64ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseTime32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseTime32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
 	return true
 }
 
-// Retain keeps a reference to the Float64ValueIterator.
-func (vr *Float64ValueIterator) Retain() {
+// Retain keeps a reference to the ReverseTime32ValueIterator.
+func (vr *ReverseTime32ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Float64ValueIterator.
-func (vr *Float64ValueIterator) Release() {
+// Release removes a reference to the ReverseTime32ValueIterator.
+func (vr *ReverseTime32ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -1180,26 +5184,27 @@ func (vr *Float64ValueIterator) Release() {
 	}
 }
 
-// Int16ValueIterator is an iterator for reading an Arrow Column value by value.
-type Int16ValueIterator struct {
+// Time64ValueIterator is an iterator for reading an Arrow Column value by value.
+type Time64ValueIterator struct {
 	refCount      int64
-	chunkIterator *Int16ChunkIterator
+	chunkIterator *Time64ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int          // current value index
-	values []int16      // current chunk values
-	ref    *array.Int16 // the chunk reference
-	done   bool         // there are no more elements for this iterator
+	index  int            // current value index
+	values []arrow.Time64 // current chunk values
+	ref    *array.Time64  // the chunk reference
+	done   bool           // there are no more elements for this iterator
+	valid  []bool         // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewInt16ValueIterator creates a new Int16ValueIterator for reading an Arrow Column.
-func NewInt16ValueIterator(col *array.Column) *Int16ValueIterator {
+// NewTime64ValueIterator creates a new Time64ValueIterator for reading an Arrow Column.
+func NewTime64ValueIterator(col *array.Column) *Time64ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewInt16ChunkIterator(col)
+	chunkIterator := NewTime64ChunkIterator(col)
 
-	return &Int16ValueIterator{
+	return &Time64ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -1211,12 +5216,12 @@ func NewInt16ValueIterator(col *array.Column) *Int16ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Int16ValueIterator) Value() (int16, bool) {
+func (vr *Time64ValueIterator) Value() (arrow.Time64, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Int16ValueIterator) ValuePointer() *int16 {
+func (vr *Time64ValueIterator) ValuePointer() *arrow.Time64 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1224,7 +5229,7 @@ func (vr *Int16ValueIterator) ValuePointer() *int16 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Int16ValueIterator) ValueInterface() interface{} {
+func (vr *Time64ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1232,20 +5237,20 @@ func (vr *Int16ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Int16ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *Time64ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return int16AsJSON(vr.values[vr.index])
+	return time64AsJSON(vr.values[vr.index])
 }
 
-func (vr *Int16ValueIterator) DataType() arrow.DataType {
+func (vr *Time64ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *Int16ValueIterator) Next() bool {
+func (vr *Time64ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -1265,7 +5270,7 @@ func (vr *Int16ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Int16ValueIterator) nextChunk() bool {
+func (vr *Time64ValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -1287,13 +5292,74 @@ func (vr *Int16ValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the Int16ValueIterator.
-func (vr *Int16ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Time64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Time64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Time64ValueIterator) At(row int64) (value arrow.Time64, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Time64ValueIterator) NextBatch(max int) ([]arrow.Time64, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Time64ValueIterator.
+func (vr *Time64ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Int16ValueIterator.
-func (vr *Int16ValueIterator) Release() {
+// Release removes a reference to the Time64ValueIterator.
+func (vr *Time64ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -1310,26 +5376,26 @@ func (vr *Int16ValueIterator) Release() {
 	}
 }
 
-// Int32ValueIterator is an iterator for reading an Arrow Column value by value.
-type Int32ValueIterator struct {
+// ReverseTime64ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseTime64ValueIterator struct {
 	refCount      int64
-	chunkIterator *Int32ChunkIterator
+	chunkIterator *ReverseTime64ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int          // current value index
-	values []int32      // current chunk values
-	ref    *array.Int32 // the chunk reference
-	done   bool         // there are no more elements for this iterator
+	index  int            // current value index
+	values []arrow.Time64 // current chunk values
+	ref    *array.Time64  // the chunk reference
+	done   bool           // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewInt32ValueIterator creates a new Int32ValueIterator for reading an Arrow Column.
-func NewInt32ValueIterator(col *array.Column) *Int32ValueIterator {
+// NewReverseTime64ValueIterator creates a new ReverseTime64ValueIterator for reading an Arrow Column.
+func NewReverseTime64ValueIterator(col *array.Column) *ReverseTime64ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewInt32ChunkIterator(col)
+	chunkIterator := NewReverseTime64ChunkIterator(col)
 
-	return &Int32ValueIterator{
+	return &ReverseTime64ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -1341,12 +5407,12 @@ func NewInt32ValueIterator(col *array.Column) *Int32ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Int32ValueIterator) Value() (int32, bool) {
+func (vr *ReverseTime64ValueIterator) Value() (arrow.Time64, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Int32ValueIterator) ValuePointer() *int32 {
+func (vr *ReverseTime64ValueIterator) ValuePointer() *arrow.Time64 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1354,7 +5420,7 @@ func (vr *Int32ValueIterator) ValuePointer() *int32 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Int32ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseTime64ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1362,29 +5428,29 @@ func (vr *Int32ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Int32ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseTime64ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return int32AsJSON(vr.values[vr.index])
+	return time64AsJSON(vr.values[vr.index])
 }
 
-func (vr *Int32ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseTime64ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Int32ValueIterator) Next() bool {
+func (vr *ReverseTime64ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -1395,8 +5461,8 @@ func (vr *Int32ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Int32ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
+func (vr *ReverseTime64ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
 		return false
@@ -1413,17 +5479,48 @@ func (vr *Int32ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = len(vr.values) - 1
 	return true
 }
 
-// Retain keeps a reference to the Int32ValueIterator.
-func (vr *Int32ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseTime64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseTime64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseTime64ValueIterator.
+func (vr *ReverseTime64ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Int32ValueIterator.
-func (vr *Int32ValueIterator) Release() {
+// Release removes a reference to the ReverseTime64ValueIterator.
+func (vr *ReverseTime64ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -1440,26 +5537,27 @@ func (vr *Int32ValueIterator) Release() {
 	}
 }
 
-// Int64ValueIterator is an iterator for reading an Arrow Column value by value.
-type Int64ValueIterator struct {
+// TimestampValueIterator is an iterator for reading an Arrow Column value by value.
+type TimestampValueIterator struct {
 	refCount      int64
-	chunkIterator *Int64ChunkIterator
+	chunkIterator *TimestampChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int          // current value index
-	values []int64      // current chunk values
-	ref    *array.Int64 // the chunk reference
-	done   bool         // there are no more elements for this iterator
+	index  int               // current value index
+	values []arrow.Timestamp // current chunk values
+	ref    *array.Timestamp  // the chunk reference
+	done   bool              // there are no more elements for this iterator
+	valid  []bool            // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewInt64ValueIterator creates a new Int64ValueIterator for reading an Arrow Column.
-func NewInt64ValueIterator(col *array.Column) *Int64ValueIterator {
+// NewTimestampValueIterator creates a new TimestampValueIterator for reading an Arrow Column.
+func NewTimestampValueIterator(col *array.Column) *TimestampValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewInt64ChunkIterator(col)
+	chunkIterator := NewTimestampChunkIterator(col)
 
-	return &Int64ValueIterator{
+	return &TimestampValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -1471,12 +5569,12 @@ func NewInt64ValueIterator(col *array.Column) *Int64ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Int64ValueIterator) Value() (int64, bool) {
+func (vr *TimestampValueIterator) Value() (arrow.Timestamp, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Int64ValueIterator) ValuePointer() *int64 {
+func (vr *TimestampValueIterator) ValuePointer() *arrow.Timestamp {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1484,7 +5582,7 @@ func (vr *Int64ValueIterator) ValuePointer() *int64 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Int64ValueIterator) ValueInterface() interface{} {
+func (vr *TimestampValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1492,20 +5590,20 @@ func (vr *Int64ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Int64ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *TimestampValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return int64AsJSON(vr.values[vr.index])
+	return timestampAsJSON(vr.values[vr.index])
 }
 
-func (vr *Int64ValueIterator) DataType() arrow.DataType {
+func (vr *TimestampValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *Int64ValueIterator) Next() bool {
+func (vr *TimestampValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -1525,7 +5623,7 @@ func (vr *Int64ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Int64ValueIterator) nextChunk() bool {
+func (vr *TimestampValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -1547,13 +5645,74 @@ func (vr *Int64ValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the Int64ValueIterator.
-func (vr *Int64ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *TimestampValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *TimestampValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *TimestampValueIterator) At(row int64) (value arrow.Timestamp, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *TimestampValueIterator) NextBatch(max int) ([]arrow.Timestamp, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the TimestampValueIterator.
+func (vr *TimestampValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Int64ValueIterator.
-func (vr *Int64ValueIterator) Release() {
+// Release removes a reference to the TimestampValueIterator.
+func (vr *TimestampValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -1570,26 +5729,26 @@ func (vr *Int64ValueIterator) Release() {
 	}
 }
 
-// Int8ValueIterator is an iterator for reading an Arrow Column value by value.
-type Int8ValueIterator struct {
+// ReverseTimestampValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseTimestampValueIterator struct {
 	refCount      int64
-	chunkIterator *Int8ChunkIterator
+	chunkIterator *ReverseTimestampChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int         // current value index
-	values []int8      // current chunk values
-	ref    *array.Int8 // the chunk reference
-	done   bool        // there are no more elements for this iterator
+	index  int               // current value index
+	values []arrow.Timestamp // current chunk values
+	ref    *array.Timestamp  // the chunk reference
+	done   bool              // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewInt8ValueIterator creates a new Int8ValueIterator for reading an Arrow Column.
-func NewInt8ValueIterator(col *array.Column) *Int8ValueIterator {
+// NewReverseTimestampValueIterator creates a new ReverseTimestampValueIterator for reading an Arrow Column.
+func NewReverseTimestampValueIterator(col *array.Column) *ReverseTimestampValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewInt8ChunkIterator(col)
+	chunkIterator := NewReverseTimestampChunkIterator(col)
 
-	return &Int8ValueIterator{
+	return &ReverseTimestampValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -1601,12 +5760,12 @@ func NewInt8ValueIterator(col *array.Column) *Int8ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Int8ValueIterator) Value() (int8, bool) {
+func (vr *ReverseTimestampValueIterator) Value() (arrow.Timestamp, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Int8ValueIterator) ValuePointer() *int8 {
+func (vr *ReverseTimestampValueIterator) ValuePointer() *arrow.Timestamp {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1614,7 +5773,7 @@ func (vr *Int8ValueIterator) ValuePointer() *int8 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Int8ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseTimestampValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1622,29 +5781,29 @@ func (vr *Int8ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Int8ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseTimestampValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return int8AsJSON(vr.values[vr.index])
+	return timestampAsJSON(vr.values[vr.index])
 }
 
-func (vr *Int8ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseTimestampValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Int8ValueIterator) Next() bool {
+func (vr *ReverseTimestampValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -1655,15 +5814,45 @@ func (vr *Int8ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Int8ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
-	if !vr.chunkIterator.Next() {
-		// No more chunks
+func (vr *ReverseTimestampValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseTimestampValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseTimestampValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
 		return false
 	}
 
-	// There was another chunk.
-	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
 	ref := vr.chunkIterator.Chunk()
 	ref.Retain()
 
@@ -1673,17 +5862,18 @@ func (vr *Int8ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = index
+	vr.done = false
 	return true
 }
 
-// Retain keeps a reference to the Int8ValueIterator.
-func (vr *Int8ValueIterator) Retain() {
+// Retain keeps a reference to the ReverseTimestampValueIterator.
+func (vr *ReverseTimestampValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Int8ValueIterator.
-func (vr *Int8ValueIterator) Release() {
+// Release removes a reference to the ReverseTimestampValueIterator.
+func (vr *ReverseTimestampValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -1700,26 +5890,27 @@ func (vr *Int8ValueIterator) Release() {
 	}
 }
 
-// MonthIntervalValueIterator is an iterator for reading an Arrow Column value by value.
-type MonthIntervalValueIterator struct {
+// Uint16ValueIterator is an iterator for reading an Arrow Column value by value.
+type Uint16ValueIterator struct {
 	refCount      int64
-	chunkIterator *MonthIntervalChunkIterator
+	chunkIterator *Uint16ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int                   // current value index
-	values []arrow.MonthInterval // current chunk values
-	ref    *array.MonthInterval  // the chunk reference
-	done   bool                  // there are no more elements for this iterator
+	index  int           // current value index
+	values []uint16      // current chunk values
+	ref    *array.Uint16 // the chunk reference
+	done   bool          // there are no more elements for this iterator
+	valid  []bool        // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewMonthIntervalValueIterator creates a new MonthIntervalValueIterator for reading an Arrow Column.
-func NewMonthIntervalValueIterator(col *array.Column) *MonthIntervalValueIterator {
+// NewUint16ValueIterator creates a new Uint16ValueIterator for reading an Arrow Column.
+func NewUint16ValueIterator(col *array.Column) *Uint16ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewMonthIntervalChunkIterator(col)
+	chunkIterator := NewUint16ChunkIterator(col)
 
-	return &MonthIntervalValueIterator{
+	return &Uint16ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -1731,12 +5922,12 @@ func NewMonthIntervalValueIterator(col *array.Column) *MonthIntervalValueIterato
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *MonthIntervalValueIterator) Value() (arrow.MonthInterval, bool) {
+func (vr *Uint16ValueIterator) Value() (uint16, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *MonthIntervalValueIterator) ValuePointer() *arrow.MonthInterval {
+func (vr *Uint16ValueIterator) ValuePointer() *uint16 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1744,7 +5935,7 @@ func (vr *MonthIntervalValueIterator) ValuePointer() *arrow.MonthInterval {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *MonthIntervalValueIterator) ValueInterface() interface{} {
+func (vr *Uint16ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1752,20 +5943,20 @@ func (vr *MonthIntervalValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *MonthIntervalValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *Uint16ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return monthIntervalAsJSON(vr.values[vr.index])
+	return uint16AsJSON(vr.values[vr.index])
 }
 
-func (vr *MonthIntervalValueIterator) DataType() arrow.DataType {
+func (vr *Uint16ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *MonthIntervalValueIterator) Next() bool {
+func (vr *Uint16ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -1785,7 +5976,7 @@ func (vr *MonthIntervalValueIterator) Next() bool {
 	return true
 }
 
-func (vr *MonthIntervalValueIterator) nextChunk() bool {
+func (vr *Uint16ValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -1807,13 +5998,74 @@ func (vr *MonthIntervalValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the MonthIntervalValueIterator.
-func (vr *MonthIntervalValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Uint16ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Uint16ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Uint16ValueIterator) At(row int64) (value uint16, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Uint16ValueIterator) NextBatch(max int) ([]uint16, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Uint16ValueIterator.
+func (vr *Uint16ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the MonthIntervalValueIterator.
-func (vr *MonthIntervalValueIterator) Release() {
+// Release removes a reference to the Uint16ValueIterator.
+func (vr *Uint16ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -1830,26 +6082,26 @@ func (vr *MonthIntervalValueIterator) Release() {
 	}
 }
 
-// Time32ValueIterator is an iterator for reading an Arrow Column value by value.
-type Time32ValueIterator struct {
+// ReverseUint16ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseUint16ValueIterator struct {
 	refCount      int64
-	chunkIterator *Time32ChunkIterator
+	chunkIterator *ReverseUint16ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int            // current value index
-	values []arrow.Time32 // current chunk values
-	ref    *array.Time32  // the chunk reference
-	done   bool           // there are no more elements for this iterator
+	index  int           // current value index
+	values []uint16      // current chunk values
+	ref    *array.Uint16 // the chunk reference
+	done   bool          // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewTime32ValueIterator creates a new Time32ValueIterator for reading an Arrow Column.
-func NewTime32ValueIterator(col *array.Column) *Time32ValueIterator {
+// NewReverseUint16ValueIterator creates a new ReverseUint16ValueIterator for reading an Arrow Column.
+func NewReverseUint16ValueIterator(col *array.Column) *ReverseUint16ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewTime32ChunkIterator(col)
+	chunkIterator := NewReverseUint16ChunkIterator(col)
 
-	return &Time32ValueIterator{
+	return &ReverseUint16ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -1861,12 +6113,12 @@ func NewTime32ValueIterator(col *array.Column) *Time32ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Time32ValueIterator) Value() (arrow.Time32, bool) {
+func (vr *ReverseUint16ValueIterator) Value() (uint16, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Time32ValueIterator) ValuePointer() *arrow.Time32 {
+func (vr *ReverseUint16ValueIterator) ValuePointer() *uint16 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1874,7 +6126,7 @@ func (vr *Time32ValueIterator) ValuePointer() *arrow.Time32 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Time32ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseUint16ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -1882,29 +6134,29 @@ func (vr *Time32ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Time32ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseUint16ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return time32AsJSON(vr.values[vr.index])
+	return uint16AsJSON(vr.values[vr.index])
 }
 
-func (vr *Time32ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseUint16ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Time32ValueIterator) Next() bool {
+func (vr *ReverseUint16ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -1915,8 +6167,8 @@ func (vr *Time32ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Time32ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
+func (vr *ReverseUint16ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
 		return false
@@ -1933,17 +6185,48 @@ func (vr *Time32ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = len(vr.values) - 1
 	return true
 }
 
-// Retain keeps a reference to the Time32ValueIterator.
-func (vr *Time32ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseUint16ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseUint16ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseUint16ValueIterator.
+func (vr *ReverseUint16ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Time32ValueIterator.
-func (vr *Time32ValueIterator) Release() {
+// Release removes a reference to the ReverseUint16ValueIterator.
+func (vr *ReverseUint16ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -1960,26 +6243,27 @@ func (vr *Time32ValueIterator) Release() {
 	}
 }
 
-// Time64ValueIterator is an iterator for reading an Arrow Column value by value.
-type Time64ValueIterator struct {
+// Uint32ValueIterator is an iterator for reading an Arrow Column value by value.
+type Uint32ValueIterator struct {
 	refCount      int64
-	chunkIterator *Time64ChunkIterator
+	chunkIterator *Uint32ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int            // current value index
-	values []arrow.Time64 // current chunk values
-	ref    *array.Time64  // the chunk reference
-	done   bool           // there are no more elements for this iterator
+	index  int           // current value index
+	values []uint32      // current chunk values
+	ref    *array.Uint32 // the chunk reference
+	done   bool          // there are no more elements for this iterator
+	valid  []bool        // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewTime64ValueIterator creates a new Time64ValueIterator for reading an Arrow Column.
-func NewTime64ValueIterator(col *array.Column) *Time64ValueIterator {
+// NewUint32ValueIterator creates a new Uint32ValueIterator for reading an Arrow Column.
+func NewUint32ValueIterator(col *array.Column) *Uint32ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewTime64ChunkIterator(col)
+	chunkIterator := NewUint32ChunkIterator(col)
 
-	return &Time64ValueIterator{
+	return &Uint32ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -1991,12 +6275,12 @@ func NewTime64ValueIterator(col *array.Column) *Time64ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Time64ValueIterator) Value() (arrow.Time64, bool) {
+func (vr *Uint32ValueIterator) Value() (uint32, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Time64ValueIterator) ValuePointer() *arrow.Time64 {
+func (vr *Uint32ValueIterator) ValuePointer() *uint32 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2004,7 +6288,7 @@ func (vr *Time64ValueIterator) ValuePointer() *arrow.Time64 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Time64ValueIterator) ValueInterface() interface{} {
+func (vr *Uint32ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2012,20 +6296,20 @@ func (vr *Time64ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Time64ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *Uint32ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return time64AsJSON(vr.values[vr.index])
+	return uint32AsJSON(vr.values[vr.index])
 }
 
-func (vr *Time64ValueIterator) DataType() arrow.DataType {
+func (vr *Uint32ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *Time64ValueIterator) Next() bool {
+func (vr *Uint32ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -2045,7 +6329,7 @@ func (vr *Time64ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Time64ValueIterator) nextChunk() bool {
+func (vr *Uint32ValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -2067,13 +6351,74 @@ func (vr *Time64ValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the Time64ValueIterator.
-func (vr *Time64ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Uint32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Uint32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Uint32ValueIterator) At(row int64) (value uint32, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Uint32ValueIterator) NextBatch(max int) ([]uint32, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Uint32ValueIterator.
+func (vr *Uint32ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Time64ValueIterator.
-func (vr *Time64ValueIterator) Release() {
+// Release removes a reference to the Uint32ValueIterator.
+func (vr *Uint32ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -2090,26 +6435,26 @@ func (vr *Time64ValueIterator) Release() {
 	}
 }
 
-// TimestampValueIterator is an iterator for reading an Arrow Column value by value.
-type TimestampValueIterator struct {
+// ReverseUint32ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseUint32ValueIterator struct {
 	refCount      int64
-	chunkIterator *TimestampChunkIterator
+	chunkIterator *ReverseUint32ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int               // current value index
-	values []arrow.Timestamp // current chunk values
-	ref    *array.Timestamp  // the chunk reference
-	done   bool              // there are no more elements for this iterator
+	index  int           // current value index
+	values []uint32      // current chunk values
+	ref    *array.Uint32 // the chunk reference
+	done   bool          // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewTimestampValueIterator creates a new TimestampValueIterator for reading an Arrow Column.
-func NewTimestampValueIterator(col *array.Column) *TimestampValueIterator {
+// NewReverseUint32ValueIterator creates a new ReverseUint32ValueIterator for reading an Arrow Column.
+func NewReverseUint32ValueIterator(col *array.Column) *ReverseUint32ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewTimestampChunkIterator(col)
+	chunkIterator := NewReverseUint32ChunkIterator(col)
 
-	return &TimestampValueIterator{
+	return &ReverseUint32ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -2121,12 +6466,12 @@ func NewTimestampValueIterator(col *array.Column) *TimestampValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *TimestampValueIterator) Value() (arrow.Timestamp, bool) {
+func (vr *ReverseUint32ValueIterator) Value() (uint32, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *TimestampValueIterator) ValuePointer() *arrow.Timestamp {
+func (vr *ReverseUint32ValueIterator) ValuePointer() *uint32 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2134,7 +6479,7 @@ func (vr *TimestampValueIterator) ValuePointer() *arrow.Timestamp {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *TimestampValueIterator) ValueInterface() interface{} {
+func (vr *ReverseUint32ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2142,29 +6487,29 @@ func (vr *TimestampValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *TimestampValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseUint32ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return timestampAsJSON(vr.values[vr.index])
+	return uint32AsJSON(vr.values[vr.index])
 }
 
-func (vr *TimestampValueIterator) DataType() arrow.DataType {
+func (vr *ReverseUint32ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *TimestampValueIterator) Next() bool {
+func (vr *ReverseUint32ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -2175,15 +6520,45 @@ func (vr *TimestampValueIterator) Next() bool {
 	return true
 }
 
-func (vr *TimestampValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
-	if !vr.chunkIterator.Next() {
-		// No more chunks
+func (vr *ReverseUint32ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
+	if !vr.chunkIterator.Next() {
+		// No more chunks
+		return false
+	}
+
+	// There was another chunk.
+	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = len(vr.values) - 1
+	return true
+}
+
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseUint32ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseUint32ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
 		return false
 	}
 
-	// There was another chunk.
-	// We maintain the ref and the values because the ref is going to allow us to retain the memory.
 	ref := vr.chunkIterator.Chunk()
 	ref.Retain()
 
@@ -2193,17 +6568,18 @@ func (vr *TimestampValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = index
+	vr.done = false
 	return true
 }
 
-// Retain keeps a reference to the TimestampValueIterator.
-func (vr *TimestampValueIterator) Retain() {
+// Retain keeps a reference to the ReverseUint32ValueIterator.
+func (vr *ReverseUint32ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the TimestampValueIterator.
-func (vr *TimestampValueIterator) Release() {
+// Release removes a reference to the ReverseUint32ValueIterator.
+func (vr *ReverseUint32ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -2220,26 +6596,27 @@ func (vr *TimestampValueIterator) Release() {
 	}
 }
 
-// Uint16ValueIterator is an iterator for reading an Arrow Column value by value.
-type Uint16ValueIterator struct {
+// Uint64ValueIterator is an iterator for reading an Arrow Column value by value.
+type Uint64ValueIterator struct {
 	refCount      int64
-	chunkIterator *Uint16ChunkIterator
+	chunkIterator *Uint64ChunkIterator
 
 	// Things we need to maintain for the iterator
 	index  int           // current value index
-	values []uint16      // current chunk values
-	ref    *array.Uint16 // the chunk reference
+	values []uint64      // current chunk values
+	ref    *array.Uint64 // the chunk reference
 	done   bool          // there are no more elements for this iterator
+	valid  []bool        // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewUint16ValueIterator creates a new Uint16ValueIterator for reading an Arrow Column.
-func NewUint16ValueIterator(col *array.Column) *Uint16ValueIterator {
+// NewUint64ValueIterator creates a new Uint64ValueIterator for reading an Arrow Column.
+func NewUint64ValueIterator(col *array.Column) *Uint64ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewUint16ChunkIterator(col)
+	chunkIterator := NewUint64ChunkIterator(col)
 
-	return &Uint16ValueIterator{
+	return &Uint64ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -2251,12 +6628,12 @@ func NewUint16ValueIterator(col *array.Column) *Uint16ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Uint16ValueIterator) Value() (uint16, bool) {
+func (vr *Uint64ValueIterator) Value() (uint64, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Uint16ValueIterator) ValuePointer() *uint16 {
+func (vr *Uint64ValueIterator) ValuePointer() *uint64 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2264,7 +6641,7 @@ func (vr *Uint16ValueIterator) ValuePointer() *uint16 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Uint16ValueIterator) ValueInterface() interface{} {
+func (vr *Uint64ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2272,20 +6649,20 @@ func (vr *Uint16ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Uint16ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *Uint64ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return uint16AsJSON(vr.values[vr.index])
+	return uint64AsJSON(vr.values[vr.index])
 }
 
-func (vr *Uint16ValueIterator) DataType() arrow.DataType {
+func (vr *Uint64ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *Uint16ValueIterator) Next() bool {
+func (vr *Uint64ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -2305,7 +6682,7 @@ func (vr *Uint16ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Uint16ValueIterator) nextChunk() bool {
+func (vr *Uint64ValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -2327,13 +6704,74 @@ func (vr *Uint16ValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the Uint16ValueIterator.
-func (vr *Uint16ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Uint64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Uint64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Uint64ValueIterator) At(row int64) (value uint64, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Uint64ValueIterator) NextBatch(max int) ([]uint64, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Uint64ValueIterator.
+func (vr *Uint64ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Uint16ValueIterator.
-func (vr *Uint16ValueIterator) Release() {
+// Release removes a reference to the Uint64ValueIterator.
+func (vr *Uint64ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -2350,26 +6788,26 @@ func (vr *Uint16ValueIterator) Release() {
 	}
 }
 
-// Uint32ValueIterator is an iterator for reading an Arrow Column value by value.
-type Uint32ValueIterator struct {
+// ReverseUint64ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseUint64ValueIterator struct {
 	refCount      int64
-	chunkIterator *Uint32ChunkIterator
+	chunkIterator *ReverseUint64ChunkIterator
 
 	// Things we need to maintain for the iterator
 	index  int           // current value index
-	values []uint32      // current chunk values
-	ref    *array.Uint32 // the chunk reference
+	values []uint64      // current chunk values
+	ref    *array.Uint64 // the chunk reference
 	done   bool          // there are no more elements for this iterator
 
 	dataType arrow.DataType
 }
 
-// NewUint32ValueIterator creates a new Uint32ValueIterator for reading an Arrow Column.
-func NewUint32ValueIterator(col *array.Column) *Uint32ValueIterator {
+// NewReverseUint64ValueIterator creates a new ReverseUint64ValueIterator for reading an Arrow Column.
+func NewReverseUint64ValueIterator(col *array.Column) *ReverseUint64ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewUint32ChunkIterator(col)
+	chunkIterator := NewReverseUint64ChunkIterator(col)
 
-	return &Uint32ValueIterator{
+	return &ReverseUint64ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -2381,12 +6819,12 @@ func NewUint32ValueIterator(col *array.Column) *Uint32ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Uint32ValueIterator) Value() (uint32, bool) {
+func (vr *ReverseUint64ValueIterator) Value() (uint64, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Uint32ValueIterator) ValuePointer() *uint32 {
+func (vr *ReverseUint64ValueIterator) ValuePointer() *uint64 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2394,7 +6832,7 @@ func (vr *Uint32ValueIterator) ValuePointer() *uint32 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Uint32ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseUint64ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2402,29 +6840,29 @@ func (vr *Uint32ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Uint32ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseUint64ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return uint32AsJSON(vr.values[vr.index])
+	return uint64AsJSON(vr.values[vr.index])
 }
 
-func (vr *Uint32ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseUint64ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Uint32ValueIterator) Next() bool {
+func (vr *ReverseUint64ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -2435,8 +6873,8 @@ func (vr *Uint32ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Uint32ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
+func (vr *ReverseUint64ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
 		return false
@@ -2453,17 +6891,48 @@ func (vr *Uint32ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = len(vr.values) - 1
 	return true
 }
 
-// Retain keeps a reference to the Uint32ValueIterator.
-func (vr *Uint32ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseUint64ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseUint64ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseUint64ValueIterator.
+func (vr *ReverseUint64ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Uint32ValueIterator.
-func (vr *Uint32ValueIterator) Release() {
+// Release removes a reference to the ReverseUint64ValueIterator.
+func (vr *ReverseUint64ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -2480,26 +6949,27 @@ func (vr *Uint32ValueIterator) Release() {
 	}
 }
 
-// Uint64ValueIterator is an iterator for reading an Arrow Column value by value.
-type Uint64ValueIterator struct {
+// Uint8ValueIterator is an iterator for reading an Arrow Column value by value.
+type Uint8ValueIterator struct {
 	refCount      int64
-	chunkIterator *Uint64ChunkIterator
+	chunkIterator *Uint8ChunkIterator
 
 	// Things we need to maintain for the iterator
-	index  int           // current value index
-	values []uint64      // current chunk values
-	ref    *array.Uint64 // the chunk reference
-	done   bool          // there are no more elements for this iterator
+	index  int          // current value index
+	values []uint8      // current chunk values
+	ref    *array.Uint8 // the chunk reference
+	done   bool         // there are no more elements for this iterator
+	valid  []bool       // validity buffer reused by NextBatch
 
 	dataType arrow.DataType
 }
 
-// NewUint64ValueIterator creates a new Uint64ValueIterator for reading an Arrow Column.
-func NewUint64ValueIterator(col *array.Column) *Uint64ValueIterator {
+// NewUint8ValueIterator creates a new Uint8ValueIterator for reading an Arrow Column.
+func NewUint8ValueIterator(col *array.Column) *Uint8ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewUint64ChunkIterator(col)
+	chunkIterator := NewUint8ChunkIterator(col)
 
-	return &Uint64ValueIterator{
+	return &Uint8ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -2511,12 +6981,12 @@ func NewUint64ValueIterator(col *array.Column) *Uint64ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Uint64ValueIterator) Value() (uint64, bool) {
+func (vr *Uint8ValueIterator) Value() (uint8, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Uint64ValueIterator) ValuePointer() *uint64 {
+func (vr *Uint8ValueIterator) ValuePointer() *uint8 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2524,7 +6994,7 @@ func (vr *Uint64ValueIterator) ValuePointer() *uint64 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Uint64ValueIterator) ValueInterface() interface{} {
+func (vr *Uint8ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2532,20 +7002,20 @@ func (vr *Uint64ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Uint64ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *Uint8ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
-	return uint64AsJSON(vr.values[vr.index])
+	return uint8AsJSON(vr.values[vr.index])
 }
 
-func (vr *Uint64ValueIterator) DataType() arrow.DataType {
+func (vr *Uint8ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
 // Next moves the iterator to the next value. This will return false
 // when there are no more values.
-func (vr *Uint64ValueIterator) Next() bool {
+func (vr *Uint8ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
@@ -2565,7 +7035,7 @@ func (vr *Uint64ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Uint64ValueIterator) nextChunk() bool {
+func (vr *Uint8ValueIterator) nextChunk() bool {
 	// Advance the chunk until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
@@ -2587,13 +7057,74 @@ func (vr *Uint64ValueIterator) nextChunk() bool {
 	return true
 }
 
-// Retain keeps a reference to the Uint64ValueIterator.
-func (vr *Uint64ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row after it.
+// SeekRow returns an error when row is out of range.
+func (vr *Uint8ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *Uint8ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// At moves the iterator to row and returns it's value and a boolean value indicating if the value is actually null.
+// It returns an error when row is out of range.
+func (vr *Uint8ValueIterator) At(row int64) (value uint8, null bool, err error) {
+	if err = vr.SeekRow(row); err != nil {
+		return value, false, err
+	}
+	value, null = vr.Value()
+	return value, null, nil
+}
+
+// NextBatch moves the iterator forward by up to max values within the current chunk and returns them
+// along with a validity slice where false means the value is actually null. The values slice shares memory
+// with the chunk and the validity slice is reused by the next call, so neither may be modified or kept.
+// The iterator is left on the last value returned. NextBatch returns empty slices when there are no more values.
+func (vr *Uint8ValueIterator) NextBatch(max int) ([]uint8, []bool) {
+	if max <= 0 || !vr.Next() {
+		return nil, nil
+	}
+
+	start := vr.index
+	end := start + max
+	if end > len(vr.values) {
+		end = len(vr.values)
+	}
+	vr.index = end - 1
+
+	vr.valid = validity(vr.valid, vr.ref, start, end)
+	return vr.values[start:end], vr.valid
+}
+
+// Retain keeps a reference to the Uint8ValueIterator.
+func (vr *Uint8ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Uint64ValueIterator.
-func (vr *Uint64ValueIterator) Release() {
+// Release removes a reference to the Uint8ValueIterator.
+func (vr *Uint8ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -2610,10 +7141,10 @@ func (vr *Uint64ValueIterator) Release() {
 	}
 }
 
-// Uint8ValueIterator is an iterator for reading an Arrow Column value by value.
-type Uint8ValueIterator struct {
+// ReverseUint8ValueIterator is an iterator for reading an Arrow Column value by value, starting from the last value.
+type ReverseUint8ValueIterator struct {
 	refCount      int64
-	chunkIterator *Uint8ChunkIterator
+	chunkIterator *ReverseUint8ChunkIterator
 
 	// Things we need to maintain for the iterator
 	index  int          // current value index
@@ -2624,12 +7155,12 @@ type Uint8ValueIterator struct {
 	dataType arrow.DataType
 }
 
-// NewUint8ValueIterator creates a new Uint8ValueIterator for reading an Arrow Column.
-func NewUint8ValueIterator(col *array.Column) *Uint8ValueIterator {
+// NewReverseUint8ValueIterator creates a new ReverseUint8ValueIterator for reading an Arrow Column.
+func NewReverseUint8ValueIterator(col *array.Column) *ReverseUint8ValueIterator {
 	// We need a ChunkIterator to read the chunks
-	chunkIterator := NewUint8ChunkIterator(col)
+	chunkIterator := NewReverseUint8ChunkIterator(col)
 
-	return &Uint8ValueIterator{
+	return &ReverseUint8ValueIterator{
 		refCount:      1,
 		chunkIterator: chunkIterator,
 
@@ -2641,12 +7172,12 @@ func NewUint8ValueIterator(col *array.Column) *Uint8ValueIterator {
 }
 
 // Value will return the current value that the iterator is on and boolean value indicating if the value is actually null.
-func (vr *Uint8ValueIterator) Value() (uint8, bool) {
+func (vr *ReverseUint8ValueIterator) Value() (uint8, bool) {
 	return vr.values[vr.index], vr.ref.IsNull(vr.index)
 }
 
 // ValuePointer will return a pointer to the current value that the iterator is on. It will return nil if the value is actually null.
-func (vr *Uint8ValueIterator) ValuePointer() *uint8 {
+func (vr *ReverseUint8ValueIterator) ValuePointer() *uint8 {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2654,7 +7185,7 @@ func (vr *Uint8ValueIterator) ValuePointer() *uint8 {
 }
 
 // ValueInterface returns the current value as an interface{}.
-func (vr *Uint8ValueIterator) ValueInterface() interface{} {
+func (vr *ReverseUint8ValueIterator) ValueInterface() interface{} {
 	if vr.ref.IsNull(vr.index) {
 		return nil
 	}
@@ -2662,29 +7193,29 @@ func (vr *Uint8ValueIterator) ValueInterface() interface{} {
 }
 
 // ValueAsJSON returns the current value as an interface{} in it's JSON representation.
-func (vr *Uint8ValueIterator) ValueAsJSON() (interface{}, error) {
+func (vr *ReverseUint8ValueIterator) ValueAsJSON() (interface{}, error) {
 	if vr.ref.IsNull(vr.index) {
 		return nil, nil
 	}
 	return uint8AsJSON(vr.values[vr.index])
 }
 
-func (vr *Uint8ValueIterator) DataType() arrow.DataType {
+func (vr *ReverseUint8ValueIterator) DataType() arrow.DataType {
 	return vr.dataType
 }
 
-// Next moves the iterator to the next value. This will return false
+// Next moves the iterator to the previous value. This will return false
 // when there are no more values.
-func (vr *Uint8ValueIterator) Next() bool {
+func (vr *ReverseUint8ValueIterator) Next() bool {
 	if vr.done {
 		return false
 	}
 
-	// Move the index up
-	vr.index++
+	// Move the index down
+	vr.index--
 
-	// Keep moving the chunk up until we get one with data
-	for vr.values == nil || vr.index >= len(vr.values) {
+	// Keep moving the chunk down until we get one with data
+	for vr.values == nil || vr.index < 0 {
 		if !vr.nextChunk() {
 			// There were no more chunks with data in them
 			vr.done = true
@@ -2695,8 +7226,8 @@ func (vr *Uint8ValueIterator) Next() bool {
 	return true
 }
 
-func (vr *Uint8ValueIterator) nextChunk() bool {
-	// Advance the chunk until we get one with data in it or we are done
+func (vr *ReverseUint8ValueIterator) nextChunk() bool {
+	// Move the chunk down until we get one with data in it or we are done
 	if !vr.chunkIterator.Next() {
 		// No more chunks
 		return false
@@ -2713,17 +7244,48 @@ func (vr *Uint8ValueIterator) nextChunk() bool {
 
 	vr.ref = ref
 	vr.values = vr.chunkIterator.ChunkValues()
-	vr.index = 0
+	vr.index = len(vr.values) - 1
 	return true
 }
 
-// Retain keeps a reference to the Uint8ValueIterator.
-func (vr *Uint8ValueIterator) Retain() {
+// SeekRow moves the iterator to row so that it becomes the current value. Next will continue from the row before it.
+// SeekRow returns an error when row is out of range.
+func (vr *ReverseUint8ValueIterator) SeekRow(row int64) error {
+	if err := checkRow(row, vr.chunkIterator.Len()); err != nil {
+		return err
+	}
+	vr.seek(row)
+	return nil
+}
+
+// seek moves the iterator to row. It returns false when row is out of range.
+func (vr *ReverseUint8ValueIterator) seek(row int64) bool {
+	index, ok := vr.chunkIterator.seekRow(row)
+	if !ok {
+		return false
+	}
+
+	ref := vr.chunkIterator.Chunk()
+	ref.Retain()
+
+	if vr.ref != nil {
+		vr.ref.Release()
+	}
+
+	vr.ref = ref
+	vr.values = vr.chunkIterator.ChunkValues()
+	vr.index = index
+	vr.done = false
+	return true
+}
+
+// Retain keeps a reference to the ReverseUint8ValueIterator.
+func (vr *ReverseUint8ValueIterator) Retain() {
 	atomic.AddInt64(&vr.refCount, 1)
 }
 
-// Release removes a reference to the Uint8ValueIterator.
-func (vr *Uint8ValueIterator) Release() {
+// Release removes a reference to the ReverseUint8ValueIterator.
+func (vr *ReverseUint8ValueIterator) Release() {
 	refs := atomic.AddInt64(&vr.refCount, -1)
 	debug.Assert(refs >= 0, "too many releases")
 	if refs == 0 {
@@ -2739,3 +7301,50 @@ func (vr *Uint8ValueIterator) Release() {
 		vr.values = nil
 	}
 }
+
+var (
+	_ RowSeeker = (*BooleanValueIterator)(nil)
+	_ RowSeeker = (*ReverseBooleanValueIterator)(nil)
+	_ RowSeeker = (*Date32ValueIterator)(nil)
+	_ RowSeeker = (*ReverseDate32ValueIterator)(nil)
+	_ RowSeeker = (*Date64ValueIterator)(nil)
+	_ RowSeeker = (*ReverseDate64ValueIterator)(nil)
+	_ RowSeeker = (*DayTimeIntervalValueIterator)(nil)
+	_ RowSeeker = (*ReverseDayTimeIntervalValueIterator)(nil)
+	_ RowSeeker = (*Decimal128ValueIterator)(nil)
+	_ RowSeeker = (*ReverseDecimal128ValueIterator)(nil)
+	_ RowSeeker = (*DurationValueIterator)(nil)
+	_ RowSeeker = (*ReverseDurationValueIterator)(nil)
+	_ RowSeeker = (*Float16ValueIterator)(nil)
+	_ RowSeeker = (*ReverseFloat16ValueIterator)(nil)
+	_ RowSeeker = (*Float32ValueIterator)(nil)
+	_ RowSeeker = (*ReverseFloat32ValueIterator)(nil)
+	_ RowSeeker = (*Float64ValueIterator)(nil)
+	_ RowSeeker = (*ReverseFloat64ValueIterator)(nil)
+	_ RowSeeker = (*Int16ValueIterator)(nil)
+	_ RowSeeker = (*ReverseInt16ValueIterator)(nil)
+	_ RowSeeker = (*Int32ValueIterator)(nil)
+	_ RowSeeker = (*ReverseInt32ValueIterator)(nil)
+	_ RowSeeker = (*Int64ValueIterator)(nil)
+	_ RowSeeker = (*ReverseInt64ValueIterator)(nil)
+	_ RowSeeker = (*Int8ValueIterator)(nil)
+	_ RowSeeker = (*ReverseInt8ValueIterator)(nil)
+	_ RowSeeker = (*MonthIntervalValueIterator)(nil)
+	_ RowSeeker = (*ReverseMonthIntervalValueIterator)(nil)
+	_ RowSeeker = (*StringValueIterator)(nil)
+	_ RowSeeker = (*ReverseStringValueIterator)(nil)
+	_ RowSeeker = (*Time32ValueIterator)(nil)
+	_ RowSeeker = (*ReverseTime32ValueIterator)(nil)
+	_ RowSeeker = (*Time64ValueIterator)(nil)
+	_ RowSeeker = (*ReverseTime64ValueIterator)(nil)
+	_ RowSeeker = (*TimestampValueIterator)(nil)
+	_ RowSeeker = (*ReverseTimestampValueIterator)(nil)
+	_ RowSeeker = (*Uint16ValueIterator)(nil)
+	_ RowSeeker = (*ReverseUint16ValueIterator)(nil)
+	_ RowSeeker = (*Uint32ValueIterator)(nil)
+	_ RowSeeker = (*ReverseUint32ValueIterator)(nil)
+	_ RowSeeker = (*Uint64ValueIterator)(nil)
+	_ RowSeeker = (*ReverseUint64ValueIterator)(nil)
+	_ RowSeeker = (*Uint8ValueIterator)(nil)
+	_ RowSeeker = (*ReverseUint8ValueIterator)(nil)
+)
@@ -17,6 +17,7 @@ package iterator_test
 import (
 	"encoding/json"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
@@ -24,12 +25,12 @@ import (
 	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/gomemtest"
 	"github.com/gomem/gomem/pkg/iterator"
 )
 
 func TestInt32ValueIterator(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	records, schema := buildRecords(pool, t)
 	var numRows int64
@@ -70,8 +71,7 @@ func TestInt32ValueIterator(t *testing.T) {
 }
 
 func TestInt32ValueIteratorPointer(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	records, schema := buildRecords(pool, t)
 	var numRows int64
@@ -111,8 +111,7 @@ func TestInt32ValueIteratorPointer(t *testing.T) {
 }
 
 func TestFloat64ValueIterator(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	schema := arrow.NewSchema(
 		[]arrow.Field{
@@ -177,8 +176,7 @@ func TestDate64ValueIterator(t *testing.T) {
 }
 
 func TestBooleanValueIterator(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	schema := arrow.NewSchema(
 		[]arrow.Field{
@@ -235,8 +233,7 @@ func TestBooleanValueIterator(t *testing.T) {
 }
 
 func TestStringValueIterator(t *testing.T) {
-	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer pool.AssertSize(t, 0)
+	pool := gomemtest.NewAllocator(t)
 
 	schema := arrow.NewSchema(
 		[]arrow.Field{
@@ -293,8 +290,7 @@ func TestStringValueIterator(t *testing.T) {
 }
 
 func TestValueAsJSON(t *testing.T) {
-	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer mem.AssertSize(t, 0)
+	mem := gomemtest.NewAllocator(t)
 
 	for _, tc := range []struct {
 		name     string
@@ -647,8 +643,7 @@ func getColumnFromFile(t *testing.T, mem memory.Allocator) iterator.ValueIterato
 }
 
 func TestSliceArray(t *testing.T) {
-	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	defer mem.AssertSize(t, 0)
+	mem := gomemtest.NewAllocator(t)
 
 	for _, tc := range []struct {
 		name  string
@@ -739,3 +734,387 @@ func TestSliceArray(t *testing.T) {
 		})
 	}
 }
+
+func TestInt32ValueIteratorSeek(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cr := iterator.NewInt32ValueIterator(tbl.Column(0))
+	defer cr.Release()
+
+	if err := cr.SeekRow(12); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cr.ValueInterface(), int32(13); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	// Next continues from the row after the one we sought to,
+	// crossing into the next chunk.
+	expected := []int32{14, 15, 16, 17, 18, 19, 20, 31}
+	for i := range expected {
+		if !cr.Next() {
+			t.Fatalf("expected a value at step %d", i)
+		}
+		if got, want := cr.ValueInterface(), expected[i]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+	}
+
+	if err := cr.SeekRow(29); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cr.ValueInterface(), int32(40); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if cr.Next() {
+		t.Fatal("expected no more values")
+	}
+
+	value, null, err := cr.At(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := null, true; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if value, null, err = cr.At(0); err != nil || value != 1 || null {
+		t.Fatalf("got=%d (null=%v, err=%v), want=1", value, null, err)
+	}
+
+	for _, row := range []int64{-1, 30} {
+		if err := cr.SeekRow(row); err == nil {
+			t.Fatalf("expected an error seeking to %d", row)
+		}
+		if _, _, err := cr.At(row); err == nil {
+			t.Fatalf("expected an error reading row %d", row)
+		}
+	}
+}
+
+func TestStringValueIteratorAt(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	b := array.NewStringBuilder(pool)
+	defer b.Release()
+
+	b.AppendValues([]string{"a", "b"}, nil)
+	chunk1 := b.NewArray()
+	defer chunk1.Release()
+
+	// an empty chunk in the middle must be skipped over
+	chunk2 := b.NewArray()
+	defer chunk2.Release()
+
+	b.AppendValues([]string{"c", "d", "e"}, []bool{true, false, true})
+	chunk3 := b.NewArray()
+	defer chunk3.Release()
+
+	chunked := array.NewChunked(arrow.BinaryTypes.String, []array.Interface{chunk1, chunk2, chunk3})
+	defer chunked.Release()
+
+	col := array.NewColumn(arrow.Field{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true}, chunked)
+	defer col.Release()
+
+	it := iterator.NewStringValueIterator(col)
+	defer it.Release()
+
+	expected := []struct {
+		row   int64
+		value string
+		null  bool
+	}{
+		{2, "c", false},
+		{4, "e", false},
+		{3, "", true},
+		{1, "b", false},
+	}
+	for _, e := range expected {
+		value, null, err := it.At(e.row)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := null, e.null; got != want {
+			t.Fatalf("row %d: got=%v, want=%v", e.row, got, want)
+		}
+		if got, want := value, e.value; !null && got != want {
+			t.Fatalf("row %d: got=%q, want=%q", e.row, got, want)
+		}
+	}
+	if !it.Next() {
+		t.Fatal("expected a value after row 1")
+	}
+	if got, want := it.ValueInterface(), "c"; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+}
+
+func TestReverseInt32ValueIterator(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	expectedValues := make([]int32, 0)
+	expectedNulls := make([]bool, 0)
+	for i := len(records) - 1; i >= 0; i-- {
+		ref := records[i].Column(0).(*array.Int32)
+		values := ref.Int32Values()
+		for j := len(values) - 1; j >= 0; j-- {
+			expectedValues = append(expectedValues, values[j])
+			expectedNulls = append(expectedNulls, ref.IsNull(j))
+		}
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cr := iterator.NewReverseInt32ValueIterator(tbl.Column(0))
+	defer cr.Release()
+
+	n := 0
+	for cr.Next() {
+		value, null := cr.Value()
+		if got, want := value, expectedValues[n]; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		if got, want := null, expectedNulls[n]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		n++
+	}
+	if got, want := n, len(expectedValues); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+
+	// Next continues backwards from the row we sought to.
+	if err := cr.SeekRow(11); err != nil {
+		t.Fatal(err)
+	}
+	expected := []interface{}{int32(12), int32(11), int32(10), nil}
+	for i := range expected {
+		if got, want := cr.ValueInterface(), expected[i]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		if !cr.Next() {
+			t.Fatal("expected a previous value")
+		}
+	}
+}
+
+func TestReverseValueIterator(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	b := array.NewStringBuilder(pool)
+	defer b.Release()
+
+	b.AppendValues([]string{"a", "b"}, []bool{true, false})
+	chunk1 := b.NewArray()
+	defer chunk1.Release()
+
+	// an empty chunk in the middle must be skipped over
+	chunk2 := b.NewArray()
+	defer chunk2.Release()
+
+	b.AppendValues([]string{"c", "d"}, nil)
+	chunk3 := b.NewArray()
+	defer chunk3.Release()
+
+	chunked := array.NewChunked(arrow.BinaryTypes.String, []array.Interface{chunk1, chunk2, chunk3})
+	defer chunked.Release()
+
+	col := array.NewColumn(arrow.Field{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true}, chunked)
+	defer col.Release()
+
+	it := iterator.NewReverseValueIterator(col)
+	defer it.Release()
+
+	if _, ok := it.(*iterator.ReverseStringValueIterator); !ok {
+		t.Fatalf("got=%T, want=*iterator.ReverseStringValueIterator", it)
+	}
+
+	expected := []interface{}{"d", "c", nil, "a"}
+	n := 0
+	for it.Next() {
+		if got, want := it.ValueInterface(), expected[n]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		n++
+	}
+	if got, want := n, len(expected); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+}
+
+func TestInt32ValueIteratorNextBatch(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	records, schema := buildRecords(pool, t)
+	for i := range records {
+		defer records[i].Release()
+	}
+
+	tbl := array.NewTableFromRecords(schema, records)
+	defer tbl.Release()
+
+	cr := iterator.NewInt32ValueIterator(tbl.Column(0))
+	defer cr.Release()
+
+	// Batches never cross a chunk boundary.
+	expectedLens := []int{4, 4, 2, 4, 4, 2, 4, 4, 2}
+	n := 0
+	var total int
+	for {
+		values, valid := cr.NextBatch(4)
+		if len(values) == 0 {
+			break
+		}
+		if got, want := len(values), expectedLens[n]; got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+		if got, want := len(valid), len(values); got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+
+		chunk := records[total/10].Column(0).(*array.Int32)
+		if got, want := &values[0], &chunk.Int32Values()[total%10]; got != want {
+			t.Fatalf("got=%p, want=%p", got, want)
+		}
+		for i := range valid {
+			if got, want := valid[i], chunk.IsValid(total%10+i); got != want {
+				t.Fatalf("row %d: got=%v, want=%v", total+i, got, want)
+			}
+		}
+
+		// The iterator is left on the last value of the batch.
+		if got, want := cr.ValueInterface(), interface{}(values[len(values)-1]); valid[len(valid)-1] && got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+
+		total += len(values)
+		n++
+	}
+	if got, want := n, len(expectedLens); got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if cr.Next() {
+		t.Fatal("expected no more values")
+	}
+}
+
+func TestStringValueIteratorNextBatch(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	b := array.NewStringBuilder(pool)
+	defer b.Release()
+
+	b.AppendValues([]string{"a", "b", "c"}, []bool{true, false, true})
+	arr := b.NewArray()
+	defer arr.Release()
+
+	it := iterator.NewInterfaceValueIterator(arrow.Field{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true}, arr).(*iterator.StringValueIterator)
+	defer it.Release()
+
+	if !it.Next() {
+		t.Fatal("expected a value")
+	}
+
+	values, valid := it.NextBatch(10)
+	if got, want := len(values), 2; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if got, want := values[1], "c"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if got, want := valid, []bool{false, true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if values, _ := it.NextBatch(10); len(values) != 0 {
+		t.Fatalf("got=%v, want no values", values)
+	}
+}
+
+func TestNestedValueIterators(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		arrow.Field{Name: "scores", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64), Nullable: true},
+	)
+
+	b := array.NewStructBuilder(pool, dtype)
+	defer b.Release()
+
+	nb := b.FieldBuilder(0).(*array.StringBuilder)
+	lb := b.FieldBuilder(1).(*array.ListBuilder)
+	vb := lb.ValueBuilder().(*array.Int64Builder)
+
+	b.Append(true)
+	nb.Append("a")
+	lb.Append(true)
+	vb.AppendValues([]int64{1, 2}, nil)
+
+	// AppendNull appends nulls to the field builders too
+	b.AppendNull()
+
+	b.Append(true)
+	nb.Append("c")
+	lb.Append(true)
+	vb.AppendValues([]int64{3, 4, 5}, []bool{true, false, true})
+
+	arr := b.NewArray()
+	defer arr.Release()
+
+	it := iterator.NewInterfaceValueIterator(arrow.Field{Name: "s", Type: dtype, Nullable: true}, arr).(*iterator.StructValueIterator)
+	defer it.Release()
+
+	if got := it.Field("name"); got != nil {
+		t.Fatalf("got=%v, want=nil before the first call to Next", got)
+	}
+
+	expectedNames := []interface{}{"a", nil, "c"}
+	expectedScores := [][]interface{}{{int64(1), int64(2)}, nil, {int64(3), nil, int64(5)}}
+	n := 0
+	for it.Next() {
+		if got, want := it.Field("name").ValueInterface(), expectedNames[n]; got != want {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		if got := it.Field("missing"); got != nil {
+			t.Fatalf("got=%v, want=nil", got)
+		}
+
+		list := it.Field("scores").(*iterator.ListValueIterator)
+		elems := list.ValueIterator()
+		if expectedScores[n] == nil {
+			if elems != nil {
+				t.Fatalf("got=%v, want=nil", elems)
+			}
+			n++
+			continue
+		}
+
+		scores := elems.(*iterator.Int64ValueIterator)
+		got := make([]interface{}, 0)
+		for scores.Next() {
+			got = append(got, scores.ValueInterface())
+		}
+		scores.Release()
+		if want := expectedScores[n]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+		n++
+	}
+	if got, want := n, 3; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package logical
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/gomem/gomem/pkg/metadata"
+	"github.com/gomem/gomem/pkg/object"
+)
+
+// The Arrow version we depend on does not have extension types.
+// Extension columns are stored using their storage type and the field
+// is marked with the extension name in it's metadata, the same way
+// the Arrow IPC format does it.
+
+// ExtensionType is a logical type stored using a physical Arrow type.
+type ExtensionType interface {
+	// ExtensionName is the unique name the type is registered with.
+	ExtensionName() string
+
+	// StorageType is the DataType the values are stored as.
+	StorageType() arrow.DataType
+
+	// CastObject converts v, either a Go value, an Object or a stored value, to the Object of the type.
+	CastObject(v interface{}) (object.Object, error)
+
+	// ToStorage returns the value to store for o, a []byte for binary storage and a string for string storage.
+	ToStorage(o object.Object) interface{}
+
+	// AsJSON returns the JSON representation of o.
+	AsJSON(o object.Object) interface{}
+}
+
+var extensionTypes = struct {
+	sync.RWMutex
+	types map[string]ExtensionType
+}{types: make(map[string]ExtensionType)}
+
+// RegisterExtensionType registers the extension type under it's name.
+// It returns an error when a type with the same name is already registered
+// or when the storage type is not a FixedSizeBinary, Binary or String type.
+func RegisterExtensionType(ext ExtensionType) error {
+	extensionTypes.Lock()
+	defer extensionTypes.Unlock()
+	name := ext.ExtensionName()
+	switch ext.StorageType().(type) {
+	case *arrow.FixedSizeBinaryType, *arrow.BinaryType, *arrow.StringType:
+	default:
+		return fmt.Errorf("logical/extension: type %q has unsupported storage type %s", name, ext.StorageType())
+	}
+	if _, ok := extensionTypes.types[name]; ok {
+		return fmt.Errorf("logical/extension: type %q is already registered", name)
+	}
+	extensionTypes.types[name] = ext
+	return nil
+}
+
+// UnregisterExtensionType removes the extension type registered under name.
+func UnregisterExtensionType(name string) error {
+	extensionTypes.Lock()
+	defer extensionTypes.Unlock()
+	if _, ok := extensionTypes.types[name]; !ok {
+		return fmt.Errorf("logical/extension: type %q is not registered", name)
+	}
+	delete(extensionTypes.types, name)
+	return nil
+}
+
+// GetExtensionType returns the extension type registered under name or nil if there is none.
+func GetExtensionType(name string) ExtensionType {
+	extensionTypes.RLock()
+	defer extensionTypes.RUnlock()
+	return extensionTypes.types[name]
+}
+
+// ExtensionField returns a Field holding values of the extension type.
+func ExtensionField(name string, ext ExtensionType, nullable bool) arrow.Field {
+	return arrow.Field{
+		Name:     name,
+		Type:     ext.StorageType(),
+		Nullable: nullable,
+		Metadata: metadata.AppendExtensionTypeMetadata(arrow.Metadata{}, ext.ExtensionName(), ""),
+	}
+}
+
+// ExtensionTypeOf returns the extension type of the field.
+// The last return value is false when the field is not marked as a
+// registered extension type or it is not stored as it's storage type.
+func ExtensionTypeOf(field arrow.Field) (ExtensionType, bool) {
+	name, ok := metadata.ExtensionTypeName(field.Metadata)
+	if !ok {
+		return nil, false
+	}
+	ext := GetExtensionType(name)
+	if ext == nil || !arrow.TypeEqual(ext.StorageType(), field.Type) {
+		return nil, false
+	}
+	return ext, true
+}
+
+// UUIDType is the "arrow.uuid" canonical extension type, stored as a FixedSizeBinary(16).
+type UUIDType struct{}
+
+func (UUIDType) ExtensionName() string { return "arrow.uuid" }
+
+func (UUIDType) StorageType() arrow.DataType { return &arrow.FixedSizeBinaryType{ByteWidth: 16} }
+
+func (UUIDType) CastObject(v interface{}) (object.Object, error) {
+	u, ok := object.CastToUUID(v)
+	if !ok {
+		return nil, fmt.Errorf("logical/extension: cannot cast %v (%T) to a UUID", v, v)
+	}
+	return u, nil
+}
+
+func (UUIDType) ToStorage(o object.Object) interface{} {
+	u := o.(object.UUID)
+	return u.Bytes()
+}
+
+func (UUIDType) AsJSON(o object.Object) interface{} { return o.(object.UUID).String() }
+
+// JSONType is the "arrow.json" canonical extension type, stored as a String.
+type JSONType struct{}
+
+func (JSONType) ExtensionName() string { return "arrow.json" }
+
+func (JSONType) StorageType() arrow.DataType { return arrow.BinaryTypes.String }
+
+func (JSONType) CastObject(v interface{}) (object.Object, error) {
+	j, ok := object.CastToJSON(v)
+	if !ok {
+		return nil, fmt.Errorf("logical/extension: cannot cast %v (%T) to JSON", v, v)
+	}
+	return j, nil
+}
+
+func (JSONType) ToStorage(o object.Object) interface{} { return o.(object.JSON).Value() }
+
+// AsJSON returns the document itself, not a string holding it.
+func (JSONType) AsJSON(o object.Object) interface{} { return o.(object.JSON) }
+
+// IPv4Type is an IPv4 address, stored as a FixedSizeBinary(4).
+type IPv4Type struct{}
+
+func (IPv4Type) ExtensionName() string { return "gomem.ipv4" }
+
+func (IPv4Type) StorageType() arrow.DataType { return &arrow.FixedSizeBinaryType{ByteWidth: 4} }
+
+func (IPv4Type) CastObject(v interface{}) (object.Object, error) {
+	a, ok := object.CastToIPv4(v)
+	if !ok {
+		return nil, fmt.Errorf("logical/extension: cannot cast %v (%T) to an IPv4 address", v, v)
+	}
+	return a, nil
+}
+
+func (IPv4Type) ToStorage(o object.Object) interface{} {
+	a := o.(object.IPv4)
+	return a.Bytes()
+}
+
+func (IPv4Type) AsJSON(o object.Object) interface{} { return o.(object.IPv4).String() }
+
+// IPv6Type is an IPv6 address, stored as a FixedSizeBinary(16).
+type IPv6Type struct{}
+
+func (IPv6Type) ExtensionName() string { return "gomem.ipv6" }
+
+func (IPv6Type) StorageType() arrow.DataType { return &arrow.FixedSizeBinaryType{ByteWidth: 16} }
+
+func (IPv6Type) CastObject(v interface{}) (object.Object, error) {
+	a, ok := object.CastToIPv6(v)
+	if !ok {
+		return nil, fmt.Errorf("logical/extension: cannot cast %v (%T) to an IPv6 address", v, v)
+	}
+	return a, nil
+}
+
+func (IPv6Type) ToStorage(o object.Object) interface{} {
+	a := o.(object.IPv6)
+	return a.Bytes()
+}
+
+func (IPv6Type) AsJSON(o object.Object) interface{} { return o.(object.IPv6).String() }
+
+func init() {
+	for _, ext := range []ExtensionType{UUIDType{}, JSONType{}, IPv4Type{}, IPv6Type{}} {
+		if err := RegisterExtensionType(ext); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package logical
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/gomem/gomem/pkg/collection"
 	"github.com/gomem/gomem/pkg/object"
@@ -13,15 +16,78 @@ func NewLogicalList(builder *array.ListBuilder) *LogicalList {
 	}
 }
 
+// NewLogicalListFromArray creates a new read only logical List over the values of arr.
+// It returns an error when the values in the lists have a type that can't be read.
+// The array is not retained, it must not be released while the list is in use.
+func NewLogicalListFromArray(arr *array.List) (*LogicalList, error) {
+	values, err := newReaderFromArray(arr.ListValues())
+	if err != nil {
+		return nil, err
+	}
+	return &LogicalList{
+		arr:    arr,
+		values: values,
+	}, nil
+}
+
 // LogicalList is a logical collection type.
 // Because LogicalList is a logical type,
 // it can hold both Objects and Collections.
+// It reads the values of the array, the values appended to the builder can not be read back.
 type LogicalList struct {
+	arr     *array.List
+	values  collection.Reader
 	builder *array.ListBuilder
 }
 
+// LogicalName returns "list".
+func (c *LogicalList) LogicalName() string { return "list" }
+
+// Len returns the number of values, including the ones appended to the builder.
+func (c *LogicalList) Len() int {
+	if c.builder != nil {
+		return c.builder.Len()
+	}
+	return c.arr.Len()
+}
+
+// CheckRead returns an error when values were appended to the builder, they can not be read back.
+func (c *LogicalList) CheckRead() error {
+	if c.builder != nil && c.builder.Len() > 0 {
+		return errors.New("logical/list: the values appended to the List builder can not be read back")
+	}
+	return nil
+}
+
+// At returns the value at index i as a ListValue, nil when the value is null.
+func (c *LogicalList) At(i int) object.Object {
+	if c.IsNull(i) {
+		return nil
+	}
+	offsets := c.arr.Offsets()
+	j := i + c.arr.Offset()
+	return ListValue{values: c.values, beg: int(offsets[j]), end: int(offsets[j+1])}
+}
+
+// IsNull returns true when the value at index i is null.
+// It panics when the value was appended to the builder, see CheckRead.
+func (c *LogicalList) IsNull(i int) bool {
+	if err := c.CheckRead(); err != nil {
+		panic(err)
+	}
+	return c.arr.IsNull(i)
+}
+
+// Iterator returns an Iterator over the values.
+func (c *LogicalList) Iterator() collection.Iterator {
+	return collection.NewIterator(c)
+}
+
 // AppendObject can append an Object.
 func (c *LogicalList) AppendObject(v object.Object) error {
+	if c.builder == nil {
+		return errors.New("logical/list: cannot append to a read only List")
+	}
 	if v == nil {
 		c.builder.AppendNull()
 		return nil
@@ -39,6 +105,9 @@ func (c *LogicalList) AppendObject(v object.Object) error {
 
 // AppendCollection can append a Collection.
 func (c *LogicalList) AppendCollection(v collection.Collection) error {
+	if c.builder == nil {
+		return errors.New("logical/list: cannot append to a read only List")
+	}
 	if v == nil {
 		c.builder.AppendNull()
 		return nil
@@ -53,3 +122,105 @@ func (c *LogicalList) AppendCollection(v collection.Collection) error {
 	// c.builder.Append(b.Value())
 	return nil
 }
+
+// newReaderFromArray returns a Reader over the values of arr, which may be a list itself.
+func newReaderFromArray(arr array.Interface) (collection.Reader, error) {
+	if list, ok := arr.(*array.List); ok {
+		return NewLogicalListFromArray(list)
+	}
+	c, ok := collection.NewCollectionFromArray(arr)
+	if !ok {
+		return nil, fmt.Errorf("logical/list: unsupported list value type %s", arr.DataType())
+	}
+	return c, nil
+}
+
+// ListValue is a value of a LogicalList, the values of the list between two offsets.
+// It is an Object so it can be read from a LogicalList and a Reader of the values in the list.
+type ListValue struct {
+	values   collection.Reader
+	beg, end int
+}
+
+// Len returns the number of values in the list.
+func (l ListValue) Len() int { return l.end - l.beg }
+
+// At returns the value at index i of the list as an Object, nil when the value is null.
+func (l ListValue) At(i int) object.Object { return l.values.At(l.beg + i) }
+
+// IsNull returns true when the value at index i of the list is null.
+func (l ListValue) IsNull(i int) bool { return l.values.IsNull(l.beg + i) }
+
+// Iterator returns an Iterator over the values in the list.
+func (l ListValue) Iterator() collection.Iterator { return collection.NewIterator(l) }
+
+// String returns the values in the list like fmt prints a slice.
+func (l ListValue) String() string {
+	values := make([]interface{}, l.Len())
+	for i := range values {
+		if v := l.At(i); v != nil {
+			values[i] = v
+		}
+	}
+	return fmt.Sprint(values)
+}
+
+// Eq returns true if both lists hold equal values in the same order.
+// Null values are equal to each other.
+func (l ListValue) Eq(r object.Object) (object.Boolean, error) {
+	if r == nil {
+		return false, nil
+	}
+	right, ok := r.(ListValue)
+	if !ok {
+		return false, fmt.Errorf("cannot cast %v to a ListValue", r)
+	}
+	if l.Len() != right.Len() {
+		return false, nil
+	}
+	for i := 0; i < l.Len(); i++ {
+		eq, err := object.Eq(l.At(i), right.At(i))
+		if err != nil || !eq {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Neq returns true if the lists don't hold equal values in the same order.
+func (l ListValue) Neq(r object.Object) (object.Boolean, error) {
+	v, err := l.Eq(r)
+	return !v, err
+}
+
+// Less is not defined on ListValue.
+func (l ListValue) Less(r object.Object) (object.Boolean, error) {
+	return false, errors.New("less than not defined on ListValue")
+}
+
+// LessEq is not defined on ListValue.
+func (l ListValue) LessEq(r object.Object) (object.Boolean, error) {
+	return false, errors.New("less than or equal to not defined on ListValue")
+}
+
+// Greater is not defined on ListValue.
+func (l ListValue) Greater(r object.Object) (object.Boolean, error) {
+	return false, errors.New("greater than not defined on ListValue")
+}
+
+// GreaterEq is not defined on ListValue.
+func (l ListValue) GreaterEq(r object.Object) (object.Boolean, error) {
+	return false, errors.New("greater than or equal to not defined on ListValue")
+}
+
+// ToBoolean returns true when the list is not empty.
+func (l ListValue) ToBoolean() object.Boolean {
+	return l.Len() > 0
+}
+
+var (
+	_ Logical                = (*LogicalList)(nil)
+	_ collection.ReadChecker = (*LogicalList)(nil)
+	_ object.Object          = ListValue{}
+	_ collection.Reader      = ListValue{}
+)
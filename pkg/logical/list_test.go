@@ -0,0 +1,137 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logical_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/gomem/gomem/pkg/gomem"
+	"github.com/gomem/gomem/pkg/gomemtest"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/object"
+)
+
+func TestLogicalListReadAccess(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	b := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int64)
+	defer b.Release()
+	vb := b.ValueBuilder().(*array.Int64Builder)
+	b.Append(true)
+	vb.AppendValues([]int64{1, 2}, nil)
+	b.AppendNull()
+	b.Append(true)
+	b.Append(true)
+	vb.AppendValues([]int64{1, 0, 2}, []bool{true, false, true})
+	b.Append(true)
+	vb.AppendValues([]int64{1, 2}, nil)
+	arr := b.NewListArray()
+	defer arr.Release()
+
+	list, err := logical.NewLogicalListFromArray(arr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for it := list.Iterator(); it.Next(); {
+		if it.IsNull() {
+			got = append(got, "(null)")
+			continue
+		}
+		got = append(got, fmt.Sprintf("%v", it.Object()))
+	}
+	if got, want := fmt.Sprint(got), "[[1 2] (null) [] [1 <nil> 2] [1 2]]"; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if list.At(1) != nil || !list.IsNull(1) {
+		t.Fatal("expected index 1 to be null")
+	}
+	value := list.At(3).(logical.ListValue)
+	if got, want := value.Len(), 3; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if got, want := value.At(2), object.Object(object.Int64(2)); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if !value.IsNull(1) {
+		t.Fatal("expected index 1 of the list to be null")
+	}
+
+	if eq, err := list.At(0).Eq(list.At(4)); err != nil || !eq {
+		t.Fatalf("got=%v (err=%v), want=true", eq, err)
+	}
+	if eq, err := list.At(0).Eq(value); err != nil || eq {
+		t.Fatalf("got=%v (err=%v), want=false", eq, err)
+	}
+	if _, err := list.At(0).Less(list.At(4)); err == nil {
+		t.Fatal("expected an error ordering lists")
+	}
+
+	if err := list.AppendObject(nil); err == nil {
+		t.Fatal("expected an error appending to a read only List")
+	}
+	if got, want := gomem.NewGomemType(list).Type(), gomem.LogicalType; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	// The values appended to a builder are counted but can't be read back.
+	built := logical.NewLogicalList(b)
+	if err := built.AppendObject(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := built.Len(), 1; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if err := built.CheckRead(); err == nil {
+		t.Fatal("expected an error reading back the values of the builder")
+	}
+}
+
+func TestLogicalListOfLists(t *testing.T) {
+	pool := gomemtest.NewAllocator(t)
+
+	b := array.NewListBuilder(pool, arrow.ListOf(arrow.BinaryTypes.String))
+	defer b.Release()
+	inner := b.ValueBuilder().(*array.ListBuilder)
+	values := inner.ValueBuilder().(*array.StringBuilder)
+	b.Append(true)
+	inner.Append(true)
+	values.AppendValues([]string{"a", "b"}, nil)
+	inner.Append(true)
+	values.Append("c")
+	arr := b.NewListArray()
+	defer arr.Release()
+
+	list, err := logical.NewLogicalListFromArray(arr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(list.At(0)), "[[a b] [c]]"; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	sb := array.NewListBuilder(pool, arrow.StructOf(arrow.Field{Name: "f", Type: arrow.PrimitiveTypes.Int64}))
+	defer sb.Release()
+	structs := sb.NewListArray()
+	defer structs.Release()
+	if _, err := logical.NewLogicalListFromArray(structs); err == nil {
+		t.Fatal("expected an error for a list of structs")
+	}
+}
@@ -20,7 +20,17 @@ import (
 )
 
 type Logical interface {
+	collection.Reader
+
+	// LogicalName is the name of the logical type, i.e. "list".
+	// It also sets Logicals apart from Collections, which otherwise have the same methods.
+	LogicalName() string
+
+	// Iterator returns an Iterator over the values.
+	Iterator() collection.Iterator
+
 	AppendObject(v object.Object) error
-	AppendCollection(v collection.Collection)
-	// At(int)
+
+	// AppendCollection appends v as a single value.
+	AppendCollection(v collection.Collection) error
 }
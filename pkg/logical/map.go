@@ -0,0 +1,65 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logical
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/gomem/gomem/pkg/metadata"
+)
+
+// The Arrow version we depend on does not have a Map array.
+// Maps are stored using the Arrow Map layout, a list of key/value
+// entries, and the field is marked as a map in it's metadata.
+
+// MapOf returns the DataType used to store a map with the given key and value types.
+func MapOf(key, value arrow.DataType) *arrow.ListType {
+	return arrow.ListOf(arrow.StructOf(
+		arrow.Field{Name: "key", Type: key},
+		arrow.Field{Name: "value", Type: value, Nullable: true},
+	))
+}
+
+// MapField returns a nullable map Field with the given key and value types.
+func MapField(name string, key, value arrow.DataType) arrow.Field {
+	return arrow.Field{
+		Name:     name,
+		Type:     MapOf(key, value),
+		Nullable: true,
+		Metadata: metadata.AppendOriginalMapTypeMetadata(arrow.Metadata{}),
+	}
+}
+
+// IsMap returns true when the field holds a map.
+func IsMap(field arrow.Field) bool {
+	if !metadata.OriginalMapTypeMetadataExists(field.Metadata) {
+		return false
+	}
+	_, _, ok := MapKeyValueFields(field.Type)
+	return ok
+}
+
+// MapKeyValueFields returns the key and value fields of a map DataType.
+// The last return value is false when dtype is not a list of key/value entries.
+func MapKeyValueFields(dtype arrow.DataType) (arrow.Field, arrow.Field, bool) {
+	list, ok := dtype.(*arrow.ListType)
+	if !ok {
+		return arrow.Field{}, arrow.Field{}, false
+	}
+	entries, ok := list.Elem().(*arrow.StructType)
+	if !ok || len(entries.Fields()) != 2 {
+		return arrow.Field{}, arrow.Field{}, false
+	}
+	return entries.Field(0), entries.Field(1), true
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logical
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/gomem/gomem/pkg/metadata"
+)
+
+// The Arrow version we depend on does not have a Union array.
+// Unions are stored as a struct whose first child holds the type code
+// of each value followed by a nullable child for each member.
+//
+// In SparseMode every member child has a slot for each value and only
+// the child of the active member holds a value, like the Arrow sparse
+// union layout.
+//
+// In DenseMode the type codes are followed by a child holding the offset
+// of each value in the child of it's member. The children of a struct must
+// all have the same length, so each member child is a list holding the value
+// in the rows where the member is active and nothing in the others.
+// The values of the list are the dense child of the member.
+
+// UnionTypeCodesName is the name of the child holding the type codes.
+const UnionTypeCodesName = "type_ids"
+
+// UnionValueOffsetsName is the name of the child holding the value offsets of a dense union.
+const UnionValueOffsetsName = "value_offsets"
+
+// UnionMode is the layout used to store the members of a union.
+type UnionMode int8
+
+const (
+	// SparseMode gives every member a slot for each value.
+	SparseMode UnionMode = iota
+	// DenseMode only stores the values of each member.
+	DenseMode
+)
+
+func (m UnionMode) String() string {
+	switch m {
+	case SparseMode:
+		return "SPARSE"
+	case DenseMode:
+		return "DENSE"
+	default:
+		return fmt.Sprintf("UnionMode(%d)", int8(m))
+	}
+}
+
+// Union describes the members of a union field.
+type Union struct {
+	Mode      UnionMode
+	Members   []arrow.Field
+	TypeCodes []int8
+}
+
+// UnionOf returns the DataType used to store a sparse union of the members.
+func UnionOf(members ...arrow.Field) *arrow.StructType {
+	fields := make([]arrow.Field, 0, len(members)+1)
+	fields = append(fields, arrow.Field{Name: UnionTypeCodesName, Type: arrow.PrimitiveTypes.Int8})
+	for _, member := range members {
+		member.Nullable = true
+		fields = append(fields, member)
+	}
+	return arrow.StructOf(fields...)
+}
+
+// DenseUnionOf returns the DataType used to store a dense union of the members.
+func DenseUnionOf(members ...arrow.Field) *arrow.StructType {
+	fields := make([]arrow.Field, 0, len(members)+2)
+	fields = append(fields,
+		arrow.Field{Name: UnionTypeCodesName, Type: arrow.PrimitiveTypes.Int8},
+		arrow.Field{Name: UnionValueOffsetsName, Type: arrow.PrimitiveTypes.Int32},
+	)
+	for _, member := range members {
+		fields = append(fields, arrow.Field{
+			Name:     member.Name,
+			Type:     arrow.ListOf(member.Type),
+			Nullable: true,
+			Metadata: member.Metadata,
+		})
+	}
+	return arrow.StructOf(fields...)
+}
+
+// UnionField returns a nullable union Field of the members stored with the mode.
+// When typeCodes is nil the members are given the type codes 0, 1, 2, etc..
+func UnionField(name string, mode UnionMode, members []arrow.Field, typeCodes []int8) arrow.Field {
+	if typeCodes == nil {
+		typeCodes = make([]int8, len(members))
+		for i := range typeCodes {
+			typeCodes[i] = int8(i)
+		}
+	}
+	if len(typeCodes) != len(members) {
+		panic(fmt.Errorf("logical/union: got %d type codes for %d members", len(typeCodes), len(members)))
+	}
+	var dtype *arrow.StructType
+	switch mode {
+	case SparseMode:
+		dtype = UnionOf(members...)
+	case DenseMode:
+		dtype = DenseUnionOf(members...)
+	default:
+		panic(fmt.Errorf("logical/union: unknown mode %v", mode))
+	}
+	return arrow.Field{
+		Name:     name,
+		Type:     dtype,
+		Nullable: true,
+		Metadata: metadata.AppendUnionTypeMetadata(arrow.Metadata{}, mode.String(), typeCodes),
+	}
+}
+
+// IsUnion returns true when the field holds a union.
+func IsUnion(field arrow.Field) bool {
+	_, ok := UnionFromField(field)
+	return ok
+}
+
+// UnionFromField returns the Union described by the field.
+// The last return value is false when the field is not a union.
+func UnionFromField(field arrow.Field) (Union, bool) {
+	if !metadata.UnionTypeMetadataExists(field.Metadata) {
+		return Union{}, false
+	}
+	dtype, ok := field.Type.(*arrow.StructType)
+	if !ok || len(dtype.Fields()) == 0 || !arrow.TypeEqual(dtype.Field(0).Type, arrow.PrimitiveTypes.Int8) {
+		return Union{}, false
+	}
+	mode, typeCodes, err := metadata.UnionTypeMetadata(field.Metadata)
+	if err != nil {
+		return Union{}, false
+	}
+	union := Union{TypeCodes: typeCodes}
+	switch mode {
+	case SparseMode.String():
+		union.Mode = SparseMode
+	case DenseMode.String():
+		union.Mode = DenseMode
+	default:
+		return Union{}, false
+	}
+	children := dtype.Fields()[union.MemberFieldIndex(0):]
+	if len(typeCodes) != len(children) {
+		return Union{}, false
+	}
+	if union.Mode == SparseMode {
+		union.Members = children
+		return union, true
+	}
+
+	if !arrow.TypeEqual(dtype.Field(1).Type, arrow.PrimitiveTypes.Int32) {
+		return Union{}, false
+	}
+	union.Members = make([]arrow.Field, len(children))
+	for i, child := range children {
+		list, ok := child.Type.(*arrow.ListType)
+		if !ok {
+			return Union{}, false
+		}
+		union.Members[i] = arrow.Field{
+			Name:     child.Name,
+			Type:     list.Elem(),
+			Nullable: true,
+			Metadata: child.Metadata,
+		}
+	}
+	return union, true
+}
+
+// MemberFieldIndex returns the index of the struct child storing the member at index i.
+func (u Union) MemberFieldIndex(i int) int {
+	if u.Mode == DenseMode {
+		return i + 2
+	}
+	return i + 1
+}
+
+// ChildIndex returns the index of the member for the type code or -1 if there is none.
+func (u Union) ChildIndex(typeCode int8) int {
+	for i, code := range u.TypeCodes {
+		if code == typeCode {
+			return i
+		}
+	}
+	return -1
+}
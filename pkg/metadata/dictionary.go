@@ -0,0 +1,109 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+// The Arrow version we depend on does not have a Dictionary array so
+// dictionary-encoded (categorical) columns are stored as their int32 indices
+// with the dictionary values kept in the field metadata.
+const (
+	dictionaryConstant  = "DICTIONARY"
+	dictionaryValuesKey = "GOMEM_DICTIONARY_VALUES"
+)
+
+// DictionaryIndexType is the storage type for the indices of a dictionary-encoded field.
+var DictionaryIndexType = arrow.PrimitiveTypes.Int32
+
+// AppendDictionaryTypeMetadata marks the field as dictionary-encoded using the provided dictionary values.
+func AppendDictionaryTypeMetadata(metadata arrow.Metadata, values []string) arrow.Metadata {
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		// Marshaling a []string can not fail.
+		panic(err)
+	}
+	metadata = AppendOriginalTypeMetadata(metadata, dictionaryConstant)
+	keys := append(metadata.Keys(), dictionaryValuesKey)
+	vals := append(metadata.Values(), string(encoded))
+	return arrow.NewMetadata(keys, vals)
+}
+
+// DictionaryTypeMetadataExists returns true when the field is dictionary-encoded.
+func DictionaryTypeMetadataExists(metadata arrow.Metadata) bool {
+	if value, ok := metadataValue(metadata, logicalTypeKey); ok {
+		return value == dictionaryConstant
+	}
+	return false
+}
+
+// DictionaryValues returns the dictionary values stored in the field metadata.
+func DictionaryValues(metadata arrow.Metadata) ([]string, error) {
+	encoded, ok := metadataValue(metadata, dictionaryValuesKey)
+	if !ok || !DictionaryTypeMetadataExists(metadata) {
+		return nil, fmt.Errorf("metadata: field is not dictionary-encoded")
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(encoded), &values); err != nil {
+		return nil, fmt.Errorf("metadata: invalid dictionary values: %w", err)
+	}
+	return values, nil
+}
+
+// SameDictionary returns true when both fields are dictionary-encoded with the same dictionary.
+// Indices of fields sharing a dictionary can be compared directly.
+func SameDictionary(left, right arrow.Metadata) bool {
+	if !DictionaryTypeMetadataExists(left) || !DictionaryTypeMetadataExists(right) {
+		return false
+	}
+	l, lok := metadataValue(left, dictionaryValuesKey)
+	r, rok := metadataValue(right, dictionaryValuesKey)
+	return lok && rok && l == r
+}
+
+// RemoveDictionaryTypeMetadata strips the dictionary markers from the metadata.
+func RemoveDictionaryTypeMetadata(metadata arrow.Metadata) arrow.Metadata {
+	if !DictionaryTypeMetadataExists(metadata) {
+		return metadata
+	}
+	return removeKeys(metadata, originalTypeKey, logicalTypeKey, dictionaryValuesKey)
+}
+
+func removeKeys(metadata arrow.Metadata, remove ...string) arrow.Metadata {
+	keys := make([]string, 0, metadata.Len())
+	values := make([]string, 0, metadata.Len())
+	for i, key := range metadata.Keys() {
+		drop := false
+		for _, r := range remove {
+			if key == r {
+				drop = true
+				break
+			}
+		}
+		if drop {
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, metadata.Values()[i])
+	}
+	if len(keys) == 0 {
+		return arrow.Metadata{}
+	}
+	return arrow.NewMetadata(keys, values)
+}
@@ -0,0 +1,37 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package metadata
+
+import "github.com/apache/arrow/go/arrow"
+
+// The Arrow version we depend on does not have extension types so
+// extension columns are stored as their storage type with the
+// extension name and serialized metadata kept in the field metadata,
+// using the same keys as the Arrow IPC format.
+const (
+	extensionNameKey     = "ARROW:extension:name"
+	extensionMetadataKey = "ARROW:extension:metadata"
+)
+
+// AppendExtensionTypeMetadata marks the field as the named extension type.
+func AppendExtensionTypeMetadata(metadata arrow.Metadata, name, serialized string) arrow.Metadata {
+	metadata = Set(metadata, extensionNameKey, name)
+	return Set(metadata, extensionMetadataKey, serialized)
+}
+
+// ExtensionTypeName returns the name of the extension type the field is marked as.
+// The last return value is false when the field is not an extension type.
+func ExtensionTypeName(metadata arrow.Metadata) (string, bool) {
+	return metadataValue(metadata, extensionNameKey)
+}
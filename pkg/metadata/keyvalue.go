@@ -0,0 +1,50 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import "github.com/apache/arrow/go/arrow"
+
+// Value returns the value for key in the metadata and whether it exists.
+func Value(metadata arrow.Metadata, key string) (string, bool) {
+	return metadataValue(metadata, key)
+}
+
+// Set returns a copy of the metadata with key set to value, replacing any value it already had.
+func Set(metadata arrow.Metadata, key, value string) arrow.Metadata {
+	keys := append([]string(nil), metadata.Keys()...)
+	values := append([]string(nil), metadata.Values()...)
+	if idx := metadata.FindKey(key); idx != -1 {
+		values[idx] = value
+		return arrow.NewMetadata(keys, values)
+	}
+	return arrow.NewMetadata(append(keys, key), append(values, value))
+}
+
+// Merge returns a copy of the metadata with the keys of others added in order.
+// A key that already exists has its value replaced by the later one.
+func Merge(metadata arrow.Metadata, others ...arrow.Metadata) arrow.Metadata {
+	merged := metadata
+	for _, other := range others {
+		for i, key := range other.Keys() {
+			merged = Set(merged, key, other.Values()[i])
+		}
+	}
+	return merged
+}
+
+// Delete returns a copy of the metadata without the given keys.
+func Delete(metadata arrow.Metadata, keys ...string) arrow.Metadata {
+	return removeKeys(metadata, keys...)
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+// The Arrow version we depend on does not have a Union array so
+// union columns are stored as a struct of the type codes and the members,
+// with the union mode and type codes kept in the field metadata.
+const (
+	unionConstant     = "UNION"
+	unionModeKey      = "GOMEM_UNION_MODE"
+	unionTypeCodesKey = "GOMEM_UNION_TYPE_CODES"
+)
+
+// AppendUnionTypeMetadata marks the field as a union with the provided mode and type codes.
+func AppendUnionTypeMetadata(metadata arrow.Metadata, mode string, typeCodes []int8) arrow.Metadata {
+	codes := make([]int, len(typeCodes))
+	for i, code := range typeCodes {
+		codes[i] = int(code)
+	}
+	encoded, err := json.Marshal(codes)
+	if err != nil {
+		// Marshaling a []int can not fail.
+		panic(err)
+	}
+	metadata = AppendOriginalTypeMetadata(metadata, unionConstant)
+	keys := append(metadata.Keys(), unionModeKey, unionTypeCodesKey)
+	vals := append(metadata.Values(), mode, string(encoded))
+	return arrow.NewMetadata(keys, vals)
+}
+
+// UnionTypeMetadataExists returns true when the field is a union.
+func UnionTypeMetadataExists(metadata arrow.Metadata) bool {
+	if value, ok := metadataValue(metadata, logicalTypeKey); ok {
+		return value == unionConstant
+	}
+	return false
+}
+
+// UnionTypeMetadata returns the union mode and type codes stored in the field metadata.
+func UnionTypeMetadata(metadata arrow.Metadata) (string, []int8, error) {
+	if !UnionTypeMetadataExists(metadata) {
+		return "", nil, fmt.Errorf("metadata: field is not a union")
+	}
+	mode, ok := metadataValue(metadata, unionModeKey)
+	if !ok {
+		return "", nil, fmt.Errorf("metadata: union mode is missing")
+	}
+	encoded, ok := metadataValue(metadata, unionTypeCodesKey)
+	if !ok {
+		return "", nil, fmt.Errorf("metadata: union type codes are missing")
+	}
+	var codes []int
+	if err := json.Unmarshal([]byte(encoded), &codes); err != nil {
+		return "", nil, fmt.Errorf("metadata: invalid union type codes: %w", err)
+	}
+	typeCodes := make([]int8, len(codes))
+	for i, code := range codes {
+		typeCodes[i] = int8(code)
+	}
+	return mode, typeCodes, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// compareBytes casts r with cast and applies f to the result of comparing the bytes of the left and right Objects.
+// A nil right Object is never equal, less or greater.
+func compareBytes(name string, left []byte, r Object, cast func(Object) ([]byte, bool), f func(cmp int) bool) (Boolean, error) {
+	if r == nil {
+		return Boolean(false), nil
+	}
+	right, ok := cast(r)
+	if !ok {
+		return false, fmt.Errorf("cannot cast %v to %s", r, name)
+	}
+	return Boolean(f(bytes.Compare(left, right))), nil
+}
+
+// isZero returns true when all the bytes are zero.
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package object
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseUUID(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"123e4567-e89b-12d3-a456-426614174000", "123e4567-e89b-12d3-a456-426614174000", true},
+		{"123E4567-E89B-12D3-A456-426614174000", "123e4567-e89b-12d3-a456-426614174000", true},
+		{"123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-426614174000", true},
+		{"123e4567-e89b-12d3-a456_426614174000", "", false},
+		{"123e4567-e89b-12d3-a456-42661417400z", "", false},
+		{"123e4567", "", false},
+	}
+	for _, c := range cases {
+		u, err := ParseUUID(c.in)
+		if got, want := err == nil, c.ok; got != want {
+			t.Errorf("%q: wrong value for ok:\ngot=%v\nwant=%v (err=%v)", c.in, got, want, err)
+			continue
+		}
+		if c.ok && u.String() != c.want {
+			t.Errorf("%q:\ngot=%v\nwant=%v", c.in, u.String(), c.want)
+		}
+	}
+}
+
+func TestParseIP(t *testing.T) {
+	cases := []struct {
+		in    string
+		want4 string
+		want6 string
+		ok4   bool
+		ok6   bool
+	}{
+		{"192.0.2.1", "192.0.2.1", "::ffff:192.0.2.1", true, true},
+		{"2001:DB8:0:0:0:0:0:1", "", "2001:db8::1", false, true},
+		{"fe80::1%eth0", "", "", false, false},
+		{"256.0.0.1", "", "", false, false},
+	}
+	for _, c := range cases {
+		a4, err := ParseIPv4(c.in)
+		if got, want := err == nil, c.ok4; got != want {
+			t.Errorf("%q: wrong value for IPv4 ok:\ngot=%v\nwant=%v", c.in, got, want)
+		} else if c.ok4 && a4.String() != c.want4 {
+			t.Errorf("%q:\ngot=%v\nwant=%v", c.in, a4.String(), c.want4)
+		}
+
+		a6, err := ParseIPv6(c.in)
+		if got, want := err == nil, c.ok6; got != want {
+			t.Errorf("%q: wrong value for IPv6 ok:\ngot=%v\nwant=%v", c.in, got, want)
+		} else if c.ok6 && a6.String() != c.want6 {
+			t.Errorf("%q:\ngot=%v\nwant=%v", c.in, a6.String(), c.want6)
+		}
+	}
+}
+
+func TestExtensionObjectsEq(t *testing.T) {
+	u, _ := ParseUUID("123e4567-e89b-12d3-a456-426614174000")
+	cases := []struct {
+		left  Object
+		right Object
+		want  bool
+	}{
+		{u, String("123E4567E89B12D3A456426614174000"), true},
+		{u, NewUUID([16]byte{}), false},
+		{IPv4{192, 0, 2, 1}, String("192.0.2.1"), true},
+		{IPv6{15: 1}, String("::1"), true},
+		{JSON(`{"a":[1,2]}`), String(`{ "a": [1, 2] }`), true},
+		{JSON(`{"a":1}`), String(`{"a":2}`), false},
+	}
+	for _, c := range cases {
+		eq, err := Eq(c.left, c.right)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := eq, Boolean(c.want); got != want {
+			t.Errorf("%v == %v:\ngot=%v\nwant=%v", c.left, c.right, got, want)
+		}
+	}
+
+	if _, err := Less(JSON(`1`), JSON(`2`)); err == nil {
+		t.Error("expected an error comparing JSON")
+	}
+	less, err := Less(IPv4{10, 0, 0, 1}, IPv4{10, 0, 0, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !less {
+		t.Error("expected 10.0.0.1 < 10.0.0.2")
+	}
+}
+
+func TestExtensionObjectsJSON(t *testing.T) {
+	u, _ := ParseUUID("123e4567-e89b-12d3-a456-426614174000")
+	v := []interface{}{u, IPv4{192, 0, 2, 1}, IPv6{0x20, 0x01, 0x0d, 0xb8, 15: 1}, JSON(`{"a":1}`)}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `["123e4567-e89b-12d3-a456-426614174000","192.0.2.1","2001:db8::1",{"a":1}]`
+	if got := string(b); got != want {
+		t.Fatalf("\ngot=%v\nwant=%v", got, want)
+	}
+
+	var back struct {
+		U  UUID
+		V4 IPv4
+		V6 IPv6
+		J  JSON
+	}
+	in := `{"U":"123e4567-e89b-12d3-a456-426614174000","V4":"192.0.2.1","V6":"2001:db8::1","J":{ "a" : 1 }}`
+	if err := json.Unmarshal([]byte(in), &back); err != nil {
+		t.Fatal(err)
+	}
+	if back.U != u || back.V4 != (IPv4{192, 0, 2, 1}) || back.V6.String() != "2001:db8::1" || back.J != JSON(`{"a":1}`) {
+		t.Fatalf("got=%+v", back)
+	}
+}
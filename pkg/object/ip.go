@@ -0,0 +1,285 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package object
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+// IPv4 is an IPv4 address.
+type IPv4 [4]byte
+
+// IPv6 is an IPv6 address. IPv4 addresses are held as IPv4-mapped IPv6 addresses.
+type IPv6 [16]byte
+
+// ParseIPv4 parses an IPv4 address in dotted decimal form, such as "192.0.2.1".
+func ParseIPv4(s string) (IPv4, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil || !addr.Is4() {
+		return IPv4{}, fmt.Errorf("invalid IPv4 address %q", s)
+	}
+	return IPv4(addr.As4()), nil
+}
+
+// ParseIPv6 parses an IPv6 address, such as "2001:db8::1".
+// An IPv4 address is parsed as an IPv4-mapped IPv6 address.
+func ParseIPv6(s string) (IPv6, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil || addr.Zone() != "" {
+		return IPv6{}, fmt.Errorf("invalid IPv6 address %q", s)
+	}
+	return IPv6(addr.As16()), nil
+}
+
+// CastToIPv4 takes an interface{} type or any Object type and attempts to convert it to an IPv4 address.
+// Strings are parsed from the dotted decimal form and byte slices must hold 4 bytes.
+func CastToIPv4(v interface{}) (IPv4, bool) {
+	switch t := v.(type) {
+	case IPv4:
+		return t, true
+	case *IPv4:
+		return *t, true
+	case [4]byte:
+		return IPv4(t), true
+	case net.IP:
+		ip := t.To4()
+		if ip == nil {
+			return IPv4{}, false
+		}
+		var a IPv4
+		copy(a[:], ip)
+		return a, true
+	case netip.Addr:
+		if !t.Is4() {
+			return IPv4{}, false
+		}
+		return IPv4(t.As4()), true
+	case []byte:
+		var a IPv4
+		if len(t) != len(a) {
+			return a, false
+		}
+		copy(a[:], t)
+		return a, true
+	case string:
+		a, err := ParseIPv4(t)
+		return a, err == nil
+	case String:
+		a, err := ParseIPv4(string(t))
+		return a, err == nil
+	default:
+		return IPv4{}, false
+	}
+}
+
+// CastToIPv6 takes an interface{} type or any Object type and attempts to convert it to an IPv6 address.
+// Strings are parsed with ParseIPv6 and byte slices must hold 16 bytes.
+func CastToIPv6(v interface{}) (IPv6, bool) {
+	switch t := v.(type) {
+	case IPv6:
+		return t, true
+	case *IPv6:
+		return *t, true
+	case IPv4:
+		return IPv6(netip.AddrFrom4(t).As16()), true
+	case [16]byte:
+		return IPv6(t), true
+	case net.IP:
+		ip := t.To16()
+		if ip == nil {
+			return IPv6{}, false
+		}
+		var a IPv6
+		copy(a[:], ip)
+		return a, true
+	case netip.Addr:
+		if !t.IsValid() || t.Zone() != "" {
+			return IPv6{}, false
+		}
+		return IPv6(t.As16()), true
+	case []byte:
+		var a IPv6
+		if len(t) != len(a) {
+			return a, false
+		}
+		copy(a[:], t)
+		return a, true
+	case string:
+		a, err := ParseIPv6(t)
+		return a, err == nil
+	case String:
+		a, err := ParseIPv6(string(t))
+		return a, err == nil
+	default:
+		return IPv6{}, false
+	}
+}
+
+// Value returns the underlying value in it's native type.
+func (e IPv4) Value() [4]byte {
+	return [4]byte(e)
+}
+
+// Bytes returns the 4 bytes of the address.
+func (e IPv4) Bytes() []byte {
+	return e[:]
+}
+
+// String returns the address in dotted decimal form.
+func (e IPv4) String() string {
+	return netip.AddrFrom4(e).String()
+}
+
+// MarshalJSON writes the address as a string in dotted decimal form.
+func (e IPv4) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON reads the address from a string in dotted decimal form.
+func (e *IPv4) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	a, err := ParseIPv4(s)
+	if err != nil {
+		return err
+	}
+	*e = a
+	return nil
+}
+
+func castIPv4Bytes(r Object) ([]byte, bool) {
+	a, ok := CastToIPv4(r)
+	return a[:], ok
+}
+
+// Eq returns true if the left IPv4 is equal to the right IPv4.
+func (e IPv4) Eq(r Object) (Boolean, error) {
+	return compareBytes("IPv4", e[:], r, castIPv4Bytes, func(cmp int) bool { return cmp == 0 })
+}
+
+// Neq returns true if the left IPv4 is not equal to the right IPv4.
+func (e IPv4) Neq(r Object) (Boolean, error) {
+	v, err := e.Eq(r)
+	return !v, err
+}
+
+// Less returns true if the left IPv4 is less than the right IPv4.
+func (e IPv4) Less(r Object) (Boolean, error) {
+	return compareBytes("IPv4", e[:], r, castIPv4Bytes, func(cmp int) bool { return cmp < 0 })
+}
+
+// LessEq returns true if the left IPv4 is less than or equal to the right IPv4.
+func (e IPv4) LessEq(r Object) (Boolean, error) {
+	return compareBytes("IPv4", e[:], r, castIPv4Bytes, func(cmp int) bool { return cmp <= 0 })
+}
+
+// Greater returns true if the left IPv4 is greater than the right IPv4.
+func (e IPv4) Greater(r Object) (Boolean, error) {
+	return compareBytes("IPv4", e[:], r, castIPv4Bytes, func(cmp int) bool { return cmp > 0 })
+}
+
+// GreaterEq returns true if the left IPv4 is greater than or equal to the right IPv4.
+func (e IPv4) GreaterEq(r Object) (Boolean, error) {
+	return compareBytes("IPv4", e[:], r, castIPv4Bytes, func(cmp int) bool { return cmp >= 0 })
+}
+
+// ToBoolean returns false for the unspecified address 0.0.0.0.
+func (e IPv4) ToBoolean() Boolean {
+	return Boolean(!isZero(e[:]))
+}
+
+// Value returns the underlying value in it's native type.
+func (e IPv6) Value() [16]byte {
+	return [16]byte(e)
+}
+
+// Bytes returns the 16 bytes of the address.
+func (e IPv6) Bytes() []byte {
+	return e[:]
+}
+
+// String returns the address in the RFC 5952 text form.
+func (e IPv6) String() string {
+	return netip.AddrFrom16(e).String()
+}
+
+// MarshalJSON writes the address as a string in the RFC 5952 text form.
+func (e IPv6) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON reads the address from a string.
+func (e *IPv6) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	a, err := ParseIPv6(s)
+	if err != nil {
+		return err
+	}
+	*e = a
+	return nil
+}
+
+func castIPv6Bytes(r Object) ([]byte, bool) {
+	a, ok := CastToIPv6(r)
+	return a[:], ok
+}
+
+// Eq returns true if the left IPv6 is equal to the right IPv6.
+func (e IPv6) Eq(r Object) (Boolean, error) {
+	return compareBytes("IPv6", e[:], r, castIPv6Bytes, func(cmp int) bool { return cmp == 0 })
+}
+
+// Neq returns true if the left IPv6 is not equal to the right IPv6.
+func (e IPv6) Neq(r Object) (Boolean, error) {
+	v, err := e.Eq(r)
+	return !v, err
+}
+
+// Less returns true if the left IPv6 is less than the right IPv6.
+func (e IPv6) Less(r Object) (Boolean, error) {
+	return compareBytes("IPv6", e[:], r, castIPv6Bytes, func(cmp int) bool { return cmp < 0 })
+}
+
+// LessEq returns true if the left IPv6 is less than or equal to the right IPv6.
+func (e IPv6) LessEq(r Object) (Boolean, error) {
+	return compareBytes("IPv6", e[:], r, castIPv6Bytes, func(cmp int) bool { return cmp <= 0 })
+}
+
+// Greater returns true if the left IPv6 is greater than the right IPv6.
+func (e IPv6) Greater(r Object) (Boolean, error) {
+	return compareBytes("IPv6", e[:], r, castIPv6Bytes, func(cmp int) bool { return cmp > 0 })
+}
+
+// GreaterEq returns true if the left IPv6 is greater than or equal to the right IPv6.
+func (e IPv6) GreaterEq(r Object) (Boolean, error) {
+	return compareBytes("IPv6", e[:], r, castIPv6Bytes, func(cmp int) bool { return cmp >= 0 })
+}
+
+// ToBoolean returns false for the unspecified address ::.
+func (e IPv6) ToBoolean() Boolean {
+	return Boolean(!isZero(e[:]))
+}
+
+var (
+	_ Object = (*IPv4)(nil)
+	_ Object = (*IPv6)(nil)
+)
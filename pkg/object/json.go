@@ -0,0 +1,133 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package object
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// JSON is a JSON document held in it's compact text form.
+type JSON string
+
+// ParseJSON validates the JSON document s and returns it in compact text form, without insignificant whitespace.
+func ParseJSON(s string) (JSON, error) {
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(s)); err != nil {
+		return "", fmt.Errorf("invalid JSON: %w", err)
+	}
+	return JSON(b.String()), nil
+}
+
+// CastToJSON takes an interface{} type or any Object type and attempts to convert it to JSON.
+// Strings and byte slices must hold a valid JSON document.
+func CastToJSON(v interface{}) (JSON, bool) {
+	switch t := v.(type) {
+	case JSON:
+		return t, true
+	case *JSON:
+		return *t, true
+	case string:
+		j, err := ParseJSON(t)
+		return j, err == nil
+	case String:
+		j, err := ParseJSON(string(t))
+		return j, err == nil
+	case []byte:
+		j, err := ParseJSON(string(t))
+		return j, err == nil
+	case json.RawMessage:
+		j, err := ParseJSON(string(t))
+		return j, err == nil
+	default:
+		return "", false
+	}
+}
+
+// Value returns the underlying value in it's native type.
+func (e JSON) Value() string {
+	return string(e)
+}
+
+// String returns the JSON document.
+func (e JSON) String() string {
+	return string(e)
+}
+
+// MarshalJSON writes the JSON document as it is, rather than as a string.
+func (e JSON) MarshalJSON() ([]byte, error) {
+	if e == "" {
+		return []byte("null"), nil
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalJSON reads the JSON document.
+func (e *JSON) UnmarshalJSON(data []byte) error {
+	j, err := ParseJSON(string(data))
+	if err != nil {
+		return err
+	}
+	*e = j
+	return nil
+}
+
+// Eq returns true if the compact text form of the left JSON is equal to the one of the right JSON.
+func (e JSON) Eq(r Object) (Boolean, error) {
+	if r == nil {
+		return Boolean(false), nil
+	}
+	right, ok := CastToJSON(r)
+	if !ok {
+		return false, fmt.Errorf("cannot cast %v to JSON", r)
+	}
+	return Boolean(e == right), nil
+}
+
+// Neq returns true if the compact text form of the left JSON is not equal to the one of the right JSON.
+func (e JSON) Neq(r Object) (Boolean, error) {
+	v, err := e.Eq(r)
+	return !v, err
+}
+
+// Less is not defined on JSON.
+func (e JSON) Less(r Object) (Boolean, error) {
+	return false, errors.New("less than not defined on JSON")
+}
+
+// LessEq is not defined on JSON.
+func (e JSON) LessEq(r Object) (Boolean, error) {
+	return false, errors.New("less than or equal to not defined on JSON")
+}
+
+// Greater is not defined on JSON.
+func (e JSON) Greater(r Object) (Boolean, error) {
+	return false, errors.New("greater than not defined on JSON")
+}
+
+// GreaterEq is not defined on JSON.
+func (e JSON) GreaterEq(r Object) (Boolean, error) {
+	return false, errors.New("greater than or equal to not defined on JSON")
+}
+
+// ToBoolean returns false for an empty document and the JSON null.
+func (e JSON) ToBoolean() Boolean {
+	return Boolean(e != "" && e != "null")
+}
+
+var (
+	_ Object = (*JSON)(nil)
+)
@@ -2510,7 +2510,7 @@ func (e Float16) compareTypes(r Object, f func(float16.Num, float16.Num) Boolean
 // Eq returns true if the left Float16 is equal to the right Float16.
 func (e Float16) Eq(r Object) (Boolean, error) {
 	return e.compareTypes(r, func(left, right float16.Num) Boolean {
-		return Boolean(left.Uint16() == right.Uint16())
+		return Boolean(left.Float32() == right.Float32())
 	})
 }
 
@@ -2528,7 +2528,7 @@ func (e Float16) Neq(r Object) (Boolean, error) {
 // is less than the right Float16.
 func (e Float16) Less(r Object) (Boolean, error) {
 	return e.compareTypes(r, func(left, right float16.Num) Boolean {
-		return Boolean(left.Uint16() < right.Uint16())
+		return Boolean(left.Float32() < right.Float32())
 	})
 }
 
@@ -2536,7 +2536,7 @@ func (e Float16) Less(r Object) (Boolean, error) {
 // is less than or equal to the right Float16.
 func (e Float16) LessEq(r Object) (Boolean, error) {
 	return e.compareTypes(r, func(left, right float16.Num) Boolean {
-		return Boolean(left.Uint16() <= right.Uint16())
+		return Boolean(left.Float32() <= right.Float32())
 	})
 }
 
@@ -2544,7 +2544,7 @@ func (e Float16) LessEq(r Object) (Boolean, error) {
 // is greter than the right Float16.
 func (e Float16) Greater(r Object) (Boolean, error) {
 	return e.compareTypes(r, func(left, right float16.Num) Boolean {
-		return Boolean(left.Uint16() > right.Uint16())
+		return Boolean(left.Float32() > right.Float32())
 	})
 }
 
@@ -2552,7 +2552,7 @@ func (e Float16) Greater(r Object) (Boolean, error) {
 // is greter than or equal to the right Float16.
 func (e Float16) GreaterEq(r Object) (Boolean, error) {
 	return e.compareTypes(r, func(left, right float16.Num) Boolean {
-		return Boolean(left.Uint16() >= right.Uint16())
+		return Boolean(left.Float32() >= right.Float32())
 	})
 }
 
@@ -9770,6 +9770,252 @@ func (t Uint8) ToUint8() Uint8 {
 	return t
 }
 
+// CastToDataType takes an interface{} type or any Object type and
+// attempts to convert it to the Object type for the Arrow DataType.
+// nil is converted to Null.
+func CastToDataType(dtype arrow.DataType, v interface{}) (Object, bool) {
+	if v == nil {
+		return NewNull(), true
+	}
+	switch dtype.(type) {
+	case *arrow.BooleanType:
+		o, ok := CastToBoolean(v)
+		return o, ok
+	case *arrow.Date32Type:
+		o, ok := CastToDate32(v)
+		return o, ok
+	case *arrow.Date64Type:
+		o, ok := CastToDate64(v)
+		return o, ok
+	case *arrow.DayTimeIntervalType:
+		o, ok := CastToDayTimeInterval(v)
+		return o, ok
+	case *arrow.Decimal128Type:
+		o, ok := CastToDecimal128(v)
+		return o, ok
+	case *arrow.DurationType:
+		o, ok := CastToDuration(v)
+		return o, ok
+	case *arrow.Float16Type:
+		o, ok := CastToFloat16(v)
+		return o, ok
+	case *arrow.Float32Type:
+		o, ok := CastToFloat32(v)
+		return o, ok
+	case *arrow.Float64Type:
+		o, ok := CastToFloat64(v)
+		return o, ok
+	case *arrow.Int16Type:
+		o, ok := CastToInt16(v)
+		return o, ok
+	case *arrow.Int32Type:
+		o, ok := CastToInt32(v)
+		return o, ok
+	case *arrow.Int64Type:
+		o, ok := CastToInt64(v)
+		return o, ok
+	case *arrow.Int8Type:
+		o, ok := CastToInt8(v)
+		return o, ok
+	case *arrow.MonthIntervalType:
+		o, ok := CastToMonthInterval(v)
+		return o, ok
+	case *arrow.StringType:
+		o, ok := CastToString(v)
+		return o, ok
+	case *arrow.Time32Type:
+		o, ok := CastToTime32(v)
+		return o, ok
+	case *arrow.Time64Type:
+		o, ok := CastToTime64(v)
+		return o, ok
+	case *arrow.TimestampType:
+		o, ok := CastToTimestamp(v)
+		return o, ok
+	case *arrow.Uint16Type:
+		o, ok := CastToUint16(v)
+		return o, ok
+	case *arrow.Uint32Type:
+		o, ok := CastToUint32(v)
+		return o, ok
+	case *arrow.Uint64Type:
+		o, ok := CastToUint64(v)
+		return o, ok
+	case *arrow.Uint8Type:
+		o, ok := CastToUint8(v)
+		return o, ok
+	default:
+		return nil, false
+	}
+}
+
+// CastToDataTypeChecked is like CastToDataType but it also returns false when
+// an Object would overflow or lose precision being converted.
+// nil is converted to Null.
+func CastToDataTypeChecked(dtype arrow.DataType, v interface{}) (Object, bool) {
+	if v == nil {
+		return NewNull(), true
+	}
+	switch dtype.(type) {
+	case *arrow.BooleanType:
+		if c, ok := v.(CastableToBoolean); ok {
+			o, ok := c.ToBooleanChecked()
+			return o, bool(ok)
+		}
+		o, ok := CastToBoolean(v)
+		return o, ok
+	case *arrow.Date32Type:
+		if c, ok := v.(CastableToDate32); ok {
+			o, ok := c.ToDate32Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToDate32(v)
+		return o, ok
+	case *arrow.Date64Type:
+		if c, ok := v.(CastableToDate64); ok {
+			o, ok := c.ToDate64Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToDate64(v)
+		return o, ok
+	case *arrow.DayTimeIntervalType:
+		if c, ok := v.(CastableToDayTimeInterval); ok {
+			o, ok := c.ToDayTimeIntervalChecked()
+			return o, bool(ok)
+		}
+		o, ok := CastToDayTimeInterval(v)
+		return o, ok
+	case *arrow.Decimal128Type:
+		if c, ok := v.(CastableToDecimal128); ok {
+			o, ok := c.ToDecimal128Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToDecimal128(v)
+		return o, ok
+	case *arrow.DurationType:
+		if c, ok := v.(CastableToDuration); ok {
+			o, ok := c.ToDurationChecked()
+			return o, bool(ok)
+		}
+		o, ok := CastToDuration(v)
+		return o, ok
+	case *arrow.Float16Type:
+		if c, ok := v.(CastableToFloat16); ok {
+			o, ok := c.ToFloat16Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToFloat16(v)
+		return o, ok
+	case *arrow.Float32Type:
+		if c, ok := v.(CastableToFloat32); ok {
+			o, ok := c.ToFloat32Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToFloat32(v)
+		return o, ok
+	case *arrow.Float64Type:
+		if c, ok := v.(CastableToFloat64); ok {
+			o, ok := c.ToFloat64Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToFloat64(v)
+		return o, ok
+	case *arrow.Int16Type:
+		if c, ok := v.(CastableToInt16); ok {
+			o, ok := c.ToInt16Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToInt16(v)
+		return o, ok
+	case *arrow.Int32Type:
+		if c, ok := v.(CastableToInt32); ok {
+			o, ok := c.ToInt32Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToInt32(v)
+		return o, ok
+	case *arrow.Int64Type:
+		if c, ok := v.(CastableToInt64); ok {
+			o, ok := c.ToInt64Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToInt64(v)
+		return o, ok
+	case *arrow.Int8Type:
+		if c, ok := v.(CastableToInt8); ok {
+			o, ok := c.ToInt8Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToInt8(v)
+		return o, ok
+	case *arrow.MonthIntervalType:
+		if c, ok := v.(CastableToMonthInterval); ok {
+			o, ok := c.ToMonthIntervalChecked()
+			return o, bool(ok)
+		}
+		o, ok := CastToMonthInterval(v)
+		return o, ok
+	case *arrow.StringType:
+		if c, ok := v.(CastableToString); ok {
+			o, ok := c.ToStringChecked()
+			return o, bool(ok)
+		}
+		o, ok := CastToString(v)
+		return o, ok
+	case *arrow.Time32Type:
+		if c, ok := v.(CastableToTime32); ok {
+			o, ok := c.ToTime32Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToTime32(v)
+		return o, ok
+	case *arrow.Time64Type:
+		if c, ok := v.(CastableToTime64); ok {
+			o, ok := c.ToTime64Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToTime64(v)
+		return o, ok
+	case *arrow.TimestampType:
+		if c, ok := v.(CastableToTimestamp); ok {
+			o, ok := c.ToTimestampChecked()
+			return o, bool(ok)
+		}
+		o, ok := CastToTimestamp(v)
+		return o, ok
+	case *arrow.Uint16Type:
+		if c, ok := v.(CastableToUint16); ok {
+			o, ok := c.ToUint16Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToUint16(v)
+		return o, ok
+	case *arrow.Uint32Type:
+		if c, ok := v.(CastableToUint32); ok {
+			o, ok := c.ToUint32Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToUint32(v)
+		return o, ok
+	case *arrow.Uint64Type:
+		if c, ok := v.(CastableToUint64); ok {
+			o, ok := c.ToUint64Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToUint64(v)
+		return o, ok
+	case *arrow.Uint8Type:
+		if c, ok := v.(CastableToUint8); ok {
+			o, ok := c.ToUint8Checked()
+			return o, bool(ok)
+		}
+		o, ok := CastToUint8(v)
+		return o, ok
+	default:
+		return nil, false
+	}
+}
+
 var (
 	_ Object = (*Boolean)(nil)
 	_ Object = (*Date32)(nil)
@@ -0,0 +1,167 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package object
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// UUID is a 128-bit universally unique identifier.
+type UUID [16]byte
+
+// NewUUID creates a new UUID object from the given bytes.
+func NewUUID(v [16]byte) UUID {
+	return UUID(v)
+}
+
+// ParseUUID parses a UUID in the canonical text form, such as "123e4567-e89b-12d3-a456-426614174000".
+// Upper case hex digits and the form without hyphens are accepted too.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	text := s
+	if len(text) == 36 {
+		if text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+			return u, fmt.Errorf("invalid UUID %q", s)
+		}
+		text = strings.Replace(text, "-", "", 4)
+	}
+	if len(text) != 32 {
+		return u, fmt.Errorf("invalid UUID %q", s)
+	}
+	if _, err := hex.Decode(u[:], []byte(text)); err != nil {
+		return u, fmt.Errorf("invalid UUID %q", s)
+	}
+	return u, nil
+}
+
+// CastToUUID takes an interface{} type or any Object type and attempts to convert it to a UUID.
+// Strings are parsed from the canonical text form and byte slices must hold 16 bytes.
+func CastToUUID(v interface{}) (UUID, bool) {
+	switch t := v.(type) {
+	case UUID:
+		return t, true
+	case *UUID:
+		return *t, true
+	case [16]byte:
+		return UUID(t), true
+	case []byte:
+		var u UUID
+		if len(t) != len(u) {
+			return u, false
+		}
+		copy(u[:], t)
+		return u, true
+	case string:
+		u, err := ParseUUID(t)
+		return u, err == nil
+	case String:
+		u, err := ParseUUID(string(t))
+		return u, err == nil
+	default:
+		return UUID{}, false
+	}
+}
+
+// Value returns the underlying value in it's native type.
+func (e UUID) Value() [16]byte {
+	return [16]byte(e)
+}
+
+// Bytes returns the 16 bytes of the UUID.
+func (e UUID) Bytes() []byte {
+	return e[:]
+}
+
+// String returns the UUID in the canonical text form.
+func (e UUID) String() string {
+	var b [36]byte
+	hex.Encode(b[0:8], e[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], e[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], e[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], e[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], e[10:])
+	return string(b[:])
+}
+
+// MarshalJSON writes the UUID as a string in the canonical text form.
+func (e UUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.String())
+}
+
+// UnmarshalJSON reads the UUID from a string in the canonical text form.
+func (e *UUID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	u, err := ParseUUID(s)
+	if err != nil {
+		return err
+	}
+	*e = u
+	return nil
+}
+
+func castUUIDBytes(r Object) ([]byte, bool) {
+	u, ok := CastToUUID(r)
+	return u[:], ok
+}
+
+// Eq returns true if the left UUID is equal to the right UUID.
+func (e UUID) Eq(r Object) (Boolean, error) {
+	return compareBytes("UUID", e[:], r, castUUIDBytes, func(cmp int) bool { return cmp == 0 })
+}
+
+// Neq returns true if the left UUID is not equal to the right UUID.
+func (e UUID) Neq(r Object) (Boolean, error) {
+	v, err := e.Eq(r)
+	return !v, err
+}
+
+// Less returns true if the bytes of the left UUID are less than the bytes of the right UUID.
+func (e UUID) Less(r Object) (Boolean, error) {
+	return compareBytes("UUID", e[:], r, castUUIDBytes, func(cmp int) bool { return cmp < 0 })
+}
+
+// LessEq returns true if the bytes of the left UUID are less than or equal to the bytes of the right UUID.
+func (e UUID) LessEq(r Object) (Boolean, error) {
+	return compareBytes("UUID", e[:], r, castUUIDBytes, func(cmp int) bool { return cmp <= 0 })
+}
+
+// Greater returns true if the bytes of the left UUID are greater than the bytes of the right UUID.
+func (e UUID) Greater(r Object) (Boolean, error) {
+	return compareBytes("UUID", e[:], r, castUUIDBytes, func(cmp int) bool { return cmp > 0 })
+}
+
+// GreaterEq returns true if the bytes of the left UUID are greater than or equal to the bytes of the right UUID.
+func (e UUID) GreaterEq(r Object) (Boolean, error) {
+	return compareBytes("UUID", e[:], r, castUUIDBytes, func(cmp int) bool { return cmp >= 0 })
+}
+
+// ToBoolean returns false for the nil UUID, which has all bits set to zero.
+func (e UUID) ToBoolean() Boolean {
+	return Boolean(!isZero(e[:]))
+}
+
+var (
+	_ Object = (*UUID)(nil)
+)
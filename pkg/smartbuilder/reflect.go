@@ -0,0 +1,180 @@
+// Copyright 2019 Nick Poorman
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smartbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/gomem/gomem/pkg/logical"
+)
+
+// tagName is the struct tag used to name fields and mark them as nullable,
+// ie. `arrow:"name,nullable"`. Fields tagged with `arrow:"-"` are skipped.
+const tagName = "arrow"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// StructField is an exported Go struct field stored in Arrow.
+type StructField struct {
+	Index    int // index of the field in the Go struct
+	Name     string
+	Nullable bool
+}
+
+// StructFields returns the fields of the Go struct type t that are stored in Arrow.
+// The name and nullability of a field can be set with it's `arrow` tag.
+func StructFields(t reflect.Type) []StructField {
+	fields := make([]StructField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// unexported
+			continue
+		}
+		tag := f.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		field := StructField{
+			Index:    i,
+			Name:     f.Name,
+			Nullable: f.Type.Kind() == reflect.Ptr,
+		}
+		opts := strings.Split(tag, ",")
+		if opts[0] != "" {
+			field.Name = opts[0]
+		}
+		for _, opt := range opts[1:] {
+			if opt == "nullable" {
+				field.Nullable = true
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// SchemaOf returns the Schema of the Go struct type t.
+// Each exported field becomes a column named after the field or it's `arrow` tag.
+func SchemaOf(t reflect.Type) (*arrow.Schema, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("smartbuilder: cannot derive a schema from %s, want a struct", t)
+	}
+	fields, err := fieldsOf(t)
+	if err != nil {
+		return nil, err
+	}
+	return arrow.NewSchema(fields, nil), nil
+}
+
+// FieldOf returns the Field used to store values of the Go type t.
+// Pointers become nullable fields, structs become struct fields, slices and arrays become lists,
+// maps become map fields and time.Time becomes a nanosecond timestamp.
+func FieldOf(name string, t reflect.Type) (arrow.Field, error) {
+	field := arrow.Field{Name: name}
+	if t.Kind() == reflect.Ptr {
+		field.Nullable = true
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		field.Type = arrow.FixedWidthTypes.Timestamp_ns
+		return field, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		field.Type = arrow.FixedWidthTypes.Boolean
+	case reflect.Int8:
+		field.Type = arrow.PrimitiveTypes.Int8
+	case reflect.Int16:
+		field.Type = arrow.PrimitiveTypes.Int16
+	case reflect.Int32:
+		field.Type = arrow.PrimitiveTypes.Int32
+	case reflect.Int64, reflect.Int:
+		field.Type = arrow.PrimitiveTypes.Int64
+	case reflect.Uint8:
+		field.Type = arrow.PrimitiveTypes.Uint8
+	case reflect.Uint16:
+		field.Type = arrow.PrimitiveTypes.Uint16
+	case reflect.Uint32:
+		field.Type = arrow.PrimitiveTypes.Uint32
+	case reflect.Uint64, reflect.Uint:
+		field.Type = arrow.PrimitiveTypes.Uint64
+	case reflect.Float32:
+		field.Type = arrow.PrimitiveTypes.Float32
+	case reflect.Float64:
+		field.Type = arrow.PrimitiveTypes.Float64
+	case reflect.String:
+		field.Type = arrow.BinaryTypes.String
+
+	case reflect.Slice, reflect.Array:
+		elem, err := FieldOf("item", t.Elem())
+		if err != nil {
+			return field, err
+		}
+		if t.Kind() == reflect.Array {
+			field.Type = arrow.FixedSizeListOf(int32(t.Len()), elem.Type)
+		} else {
+			field.Type = arrow.ListOf(elem.Type)
+		}
+		field.Nullable = field.Nullable || t.Kind() == reflect.Slice
+
+	case reflect.Map:
+		key, err := FieldOf("key", t.Key())
+		if err != nil {
+			return field, err
+		}
+		value, err := FieldOf("value", t.Elem())
+		if err != nil {
+			return field, err
+		}
+		mapField := logical.MapField(name, key.Type, value.Type)
+		return mapField, nil
+
+	case reflect.Struct:
+		fields, err := fieldsOf(t)
+		if err != nil {
+			return field, err
+		}
+		field.Type = arrow.StructOf(fields...)
+
+	default:
+		return field, fmt.Errorf("smartbuilder: unsupported type %s", t)
+	}
+
+	return field, nil
+}
+
+func fieldsOf(t reflect.Type) ([]arrow.Field, error) {
+	sfs := StructFields(t)
+	fields := make([]arrow.Field, len(sfs))
+	for i, sf := range sfs {
+		field, err := FieldOf(sf.Name, t.Field(sf.Index).Type)
+		if err != nil {
+			return nil, fmt.Errorf("smartbuilder: field %s.%s: %w", t.Name(), t.Field(sf.Index).Name, err)
+		}
+		field.Nullable = field.Nullable || sf.Nullable
+		fields[i] = field
+	}
+	return fields, nil
+}
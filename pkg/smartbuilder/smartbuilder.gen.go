@@ -20,7 +20,10 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/gomem/gomem/internal/debug"
 	"github.com/gomem/gomem/pkg/object"
 )
@@ -184,29 +187,30 @@ func (sb *SmartBuilder) appendValue(bldr array.Builder, v interface{}) error {
 		}
 		b.Append(vT.Value())
 
+	case *array.BinaryBuilder:
+		switch v := v.(type) {
+		case []byte:
+			b.Append(v)
+		case string:
+			b.AppendString(v)
+		default:
+			return fmt.Errorf("cannot cast %T to []byte", v)
+		}
+
 	case *array.ListBuilder:
-		b.Append(true)
-		sub := b.ValueBuilder()
 		v := reflect.ValueOf(v)
-		for i := 0; i < v.Len(); i++ {
-			sb.appendValue(sub, v.Index(i).Interface())
+		if v.Kind() == reflect.Map {
+			return sb.appendMap(b, v)
 		}
+		b.Append(true)
+		return sb.appendElements(b.ValueBuilder(), v)
 
 	case *array.FixedSizeListBuilder:
 		b.Append(true)
-		sub := b.ValueBuilder()
-		v := reflect.ValueOf(v)
-		for i := 0; i < v.Len(); i++ {
-			sb.appendValue(sub, v.Index(i).Interface())
-		}
+		return sb.appendElements(b.ValueBuilder(), reflect.ValueOf(v))
 
 	case *array.StructBuilder:
-		b.Append(true)
-		v := reflect.ValueOf(v)
-		for i := 0; i < b.NumField(); i++ {
-			f := b.FieldBuilder(i)
-			sb.appendValue(f, v.Field(i).Interface())
-		}
+		return sb.appendStruct(b, reflect.ValueOf(v))
 
 	default:
 		return fmt.Errorf("builder/smartbuilder: unhandled Arrow builder type %T", b)
@@ -214,3 +218,158 @@ func (sb *SmartBuilder) appendValue(bldr array.Builder, v interface{}) error {
 
 	return nil
 }
+
+// dataTypeMatches returns true when the Go type of v is stored as the Arrow DataType.
+func dataTypeMatches(dtype arrow.DataType, v interface{}) bool {
+	switch dtype.(type) {
+
+	case *arrow.BooleanType:
+		switch v.(type) {
+		case bool, *bool, object.Boolean, *object.Boolean:
+			return true
+		}
+
+	case *arrow.Date32Type:
+		switch v.(type) {
+		case arrow.Date32, *arrow.Date32, object.Date32, *object.Date32:
+			return true
+		}
+
+	case *arrow.Date64Type:
+		switch v.(type) {
+		case arrow.Date64, *arrow.Date64, object.Date64, *object.Date64:
+			return true
+		}
+
+	case *arrow.DayTimeIntervalType:
+		switch v.(type) {
+		case arrow.DayTimeInterval, *arrow.DayTimeInterval, object.DayTimeInterval, *object.DayTimeInterval:
+			return true
+		}
+
+	case *arrow.Decimal128Type:
+		switch v.(type) {
+		case decimal128.Num, *decimal128.Num, object.Decimal128, *object.Decimal128:
+			return true
+		}
+
+	case *arrow.DurationType:
+		switch v.(type) {
+		case arrow.Duration, *arrow.Duration, object.Duration, *object.Duration:
+			return true
+		}
+
+	case *arrow.Float16Type:
+		switch v.(type) {
+		case float16.Num, *float16.Num, object.Float16, *object.Float16:
+			return true
+		}
+
+	case *arrow.Float32Type:
+		switch v.(type) {
+		case float32, *float32, object.Float32, *object.Float32:
+			return true
+		}
+
+	case *arrow.Float64Type:
+		switch v.(type) {
+		case float64, *float64, object.Float64, *object.Float64:
+			return true
+		}
+
+	case *arrow.Int16Type:
+		switch v.(type) {
+		case int16, *int16, object.Int16, *object.Int16:
+			return true
+		}
+
+	case *arrow.Int32Type:
+		switch v.(type) {
+		case int32, *int32, object.Int32, *object.Int32:
+			return true
+		}
+
+	case *arrow.Int64Type:
+		switch v.(type) {
+		case int64, *int64, object.Int64, *object.Int64:
+			return true
+		}
+
+	case *arrow.Int8Type:
+		switch v.(type) {
+		case int8, *int8, object.Int8, *object.Int8:
+			return true
+		}
+
+	case *arrow.MonthIntervalType:
+		switch v.(type) {
+		case arrow.MonthInterval, *arrow.MonthInterval, object.MonthInterval, *object.MonthInterval:
+			return true
+		}
+
+	case *arrow.StringType:
+		switch v.(type) {
+		case string, *string, object.String, *object.String:
+			return true
+		}
+
+	case *arrow.Time32Type:
+		switch v.(type) {
+		case arrow.Time32, *arrow.Time32, object.Time32, *object.Time32:
+			return true
+		}
+
+	case *arrow.Time64Type:
+		switch v.(type) {
+		case arrow.Time64, *arrow.Time64, object.Time64, *object.Time64:
+			return true
+		}
+
+	case *arrow.TimestampType:
+		switch v.(type) {
+		case arrow.Timestamp, *arrow.Timestamp, object.Timestamp, *object.Timestamp:
+			return true
+		}
+
+	case *arrow.Uint16Type:
+		switch v.(type) {
+		case uint16, *uint16, object.Uint16, *object.Uint16:
+			return true
+		}
+
+	case *arrow.Uint32Type:
+		switch v.(type) {
+		case uint32, *uint32, object.Uint32, *object.Uint32:
+			return true
+		}
+
+	case *arrow.Uint64Type:
+		switch v.(type) {
+		case uint64, *uint64, object.Uint64, *object.Uint64:
+			return true
+		}
+
+	case *arrow.Uint8Type:
+		switch v.(type) {
+		case uint8, *uint8, object.Uint8, *object.Uint8:
+			return true
+		}
+
+	case *arrow.BinaryType:
+		switch v.(type) {
+		case []byte:
+			return true
+		}
+
+	case *arrow.ListType, *arrow.FixedSizeListType:
+		switch reflect.ValueOf(v).Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return true
+		}
+
+	case *arrow.StructType:
+		return reflect.ValueOf(v).Kind() == reflect.Struct
+	}
+
+	return false
+}
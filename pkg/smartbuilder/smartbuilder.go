@@ -15,20 +15,65 @@
 package smartbuilder
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/gomem/gomem/internal/debug"
+	"github.com/gomem/gomem/pkg/logical"
+	"github.com/gomem/gomem/pkg/metadata"
+	"github.com/gomem/gomem/pkg/object"
 )
 
 // SmartBuilder knows how to convert to the correct type when building.
 type SmartBuilder struct {
 	recordBuilder *array.RecordBuilder
+
+	// dictionaries holds the value to index lookup for
+	// each dictionary-encoded field, nil for other fields.
+	dictionaries []map[string]int32
+
+	// unions holds the members of each union field, nil for other fields.
+	unions []*logical.Union
+
+	// extensions holds the type of each extension field, nil for other fields.
+	extensions []logical.ExtensionType
 }
 
 // NewSmartBuilder creates a SmartBuilder that knows how to convert to the correct type when building.
 func NewSmartBuilder(recordBuilder *array.RecordBuilder) *SmartBuilder {
-	// lenFields := len(recordBuilder.Fields())
+	fields := recordBuilder.Schema().Fields()
 	sb := &SmartBuilder{
 		recordBuilder: recordBuilder,
+		dictionaries:  make([]map[string]int32, len(fields)),
+		unions:        make([]*logical.Union, len(fields)),
+		extensions:    make([]logical.ExtensionType, len(fields)),
+	}
+
+	for i, field := range fields {
+		if ext, ok := logical.ExtensionTypeOf(field); ok {
+			sb.extensions[i] = ext
+			continue
+		}
+		if union, ok := logical.UnionFromField(field); ok {
+			sb.unions[i] = &union
+			continue
+		}
+		if !metadata.DictionaryTypeMetadataExists(field.Metadata) {
+			continue
+		}
+		values, err := metadata.DictionaryValues(field.Metadata)
+		if err != nil {
+			panic(fmt.Errorf("smartbuilder: field %q: %w", field.Name, err))
+		}
+		lookup := make(map[string]int32, len(values))
+		for idx, value := range values {
+			lookup[value] = int32(idx)
+		}
+		sb.dictionaries[i] = lookup
 	}
 
 	return sb
@@ -37,13 +82,279 @@ func NewSmartBuilder(recordBuilder *array.RecordBuilder) *SmartBuilder {
 func (sb *SmartBuilder) Append(fieldIndex int, v interface{}) error {
 	builder := sb.recordBuilder.Field(fieldIndex)
 	debug.Assert(builder != nil, "Append/builder is nil")
+	v = widenValue(reflect.ValueOf(v))
 	if v == nil {
 		builder.AppendNull()
 		return nil
 	}
+	if dictionary := sb.dictionaries[fieldIndex]; dictionary != nil {
+		return sb.appendDictionaryValue(builder, dictionary, v)
+	}
+	if union := sb.unions[fieldIndex]; union != nil {
+		return sb.appendUnionValue(builder, union, v)
+	}
+	if ext := sb.extensions[fieldIndex]; ext != nil {
+		return appendExtensionValue(builder, ext, v)
+	}
 	return sb.appendValue(builder, v)
 }
 
+// AppendStruct appends the exported fields of the Go struct v as a row.
+// The fields must match the schema returned by SchemaOf for the type of v.
+func (sb *SmartBuilder) AppendStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return fmt.Errorf("smartbuilder: cannot append a nil %s", rv.Type())
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("smartbuilder: cannot append %T as a struct", v)
+	}
+
+	fields := StructFields(rv.Type())
+	if got, want := len(fields), len(sb.recordBuilder.Fields()); got != want {
+		return fmt.Errorf("smartbuilder: %s has %d fields, want %d", rv.Type(), got, want)
+	}
+	for i, field := range fields {
+		if err := sb.Append(i, rv.Field(field.Index).Interface()); err != nil {
+			return fmt.Errorf("smartbuilder: field %q: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// appendDictionaryValue appends v to a dictionary-encoded field.
+// v may either be a value in the dictionary or an int32 index into it.
+func (sb *SmartBuilder) appendDictionaryValue(bldr array.Builder, dictionary map[string]int32, v interface{}) error {
+	b, ok := bldr.(*array.Int32Builder)
+	if !ok {
+		return fmt.Errorf("smartbuilder: dictionary indices must be built with *array.Int32Builder, got %T", bldr)
+	}
+
+	switch idx := v.(type) {
+	case int32:
+		return appendDictionaryIndex(b, dictionary, idx)
+	case object.Int32:
+		return appendDictionaryIndex(b, dictionary, idx.Value())
+	}
+
+	value, ok := object.CastToString(v)
+	if !ok {
+		return fmt.Errorf("cannot cast %T to object.String", v)
+	}
+	idx, ok := dictionary[value.Value()]
+	if !ok {
+		return fmt.Errorf("smartbuilder: value %q is not in the dictionary", value.Value())
+	}
+	b.Append(idx)
+	return nil
+}
+
+func appendDictionaryIndex(b *array.Int32Builder, dictionary map[string]int32, idx int32) error {
+	if idx < 0 || int(idx) >= len(dictionary) {
+		return fmt.Errorf("smartbuilder: dictionary index %d out of range [0, %d)", idx, len(dictionary))
+	}
+	b.Append(idx)
+	return nil
+}
+
+// appendExtensionValue appends v to an extension field.
+// v may be anything the extension type can cast to it's Object, such as a [16]byte or a string for a UUID.
+func appendExtensionValue(bldr array.Builder, ext logical.ExtensionType, v interface{}) error {
+	o, err := ext.CastObject(v)
+	if err != nil {
+		return fmt.Errorf("smartbuilder: %w", err)
+	}
+	switch b := bldr.(type) {
+	case *array.FixedSizeBinaryBuilder:
+		b.Append(ext.ToStorage(o).([]byte))
+	case *array.BinaryBuilder:
+		b.Append(ext.ToStorage(o).([]byte))
+	case *array.StringBuilder:
+		b.Append(ext.ToStorage(o).(string))
+	default:
+		return fmt.Errorf("smartbuilder: unhandled storage builder %T for extension type %q", bldr, ext.ExtensionName())
+	}
+	return nil
+}
+
+// appendUnionValue appends v to a union field.
+// The member is the first one whose type matches the Go type of v.
+func (sb *SmartBuilder) appendUnionValue(bldr array.Builder, union *logical.Union, v interface{}) error {
+	b, ok := bldr.(*array.StructBuilder)
+	if !ok {
+		return fmt.Errorf("smartbuilder: unions must be built with *array.StructBuilder, got %T", bldr)
+	}
+
+	member := -1
+	for i := range union.Members {
+		if dataTypeMatches(union.Members[i].Type, v) {
+			member = i
+			break
+		}
+	}
+	if member < 0 {
+		return fmt.Errorf("smartbuilder: no union member for %T", v)
+	}
+
+	b.Append(true)
+	b.FieldBuilder(0).(*array.Int8Builder).Append(union.TypeCodes[member])
+	if union.Mode == logical.DenseMode {
+		return sb.appendDenseUnionValue(b, union, member, v)
+	}
+	for i := range union.Members {
+		fb := b.FieldBuilder(union.MemberFieldIndex(i))
+		if i != member {
+			fb.AppendNull()
+			continue
+		}
+		if err := sb.appendValue(fb, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendDenseUnionValue appends v to the values of the member list and it's position to the value offsets.
+// The lists of the other members are left empty for the row.
+func (sb *SmartBuilder) appendDenseUnionValue(b *array.StructBuilder, union *logical.Union, member int, v interface{}) error {
+	for i := range union.Members {
+		lb := b.FieldBuilder(union.MemberFieldIndex(i)).(*array.ListBuilder)
+		if i != member {
+			lb.AppendNull()
+			continue
+		}
+		lb.Append(true)
+		b.FieldBuilder(1).(*array.Int32Builder).Append(int32(lb.ValueBuilder().Len()))
+		if err := sb.appendValue(lb.ValueBuilder(), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendMap appends the Go map v as a list of key/value entries.
+// Entries are appended in sorted key order so the output is deterministic.
+func (sb *SmartBuilder) appendMap(b *array.ListBuilder, v reflect.Value) error {
+	if v.IsNil() {
+		b.AppendNull()
+		return nil
+	}
+	entries, ok := b.ValueBuilder().(*array.StructBuilder)
+	if !ok || entries.NumField() != 2 {
+		return fmt.Errorf("smartbuilder: cannot append %s to a list of %T, want a list of key/value structs", v.Type(), b.ValueBuilder())
+	}
+
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return lessMapKey(keys[i], keys[j])
+	})
+
+	b.Append(true)
+	for _, key := range keys {
+		entries.Append(true)
+		if err := sb.appendValue(entries.FieldBuilder(0), widenValue(key)); err != nil {
+			return err
+		}
+		value := widenValue(v.MapIndex(key))
+		if value == nil {
+			entries.FieldBuilder(1).AppendNull()
+			continue
+		}
+		if err := sb.appendValue(entries.FieldBuilder(1), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendElements appends the elements of the Go slice or array v to the list values.
+func (sb *SmartBuilder) appendElements(b array.Builder, v reflect.Value) error {
+	for i := 0; i < v.Len(); i++ {
+		elem := widenValue(v.Index(i))
+		if elem == nil {
+			b.AppendNull()
+			continue
+		}
+		if err := sb.appendValue(b, elem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendStruct appends the exported fields of the Go struct v to the struct fields.
+func (sb *SmartBuilder) appendStruct(b *array.StructBuilder, v reflect.Value) error {
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("smartbuilder: cannot append %s to a struct", v.Type())
+	}
+	fields := StructFields(v.Type())
+	if got, want := len(fields), b.NumField(); got != want {
+		return fmt.Errorf("smartbuilder: %s has %d fields, want %d", v.Type(), got, want)
+	}
+
+	b.Append(true)
+	for i, field := range fields {
+		fb := b.FieldBuilder(i)
+		fv := widenValue(v.Field(field.Index))
+		if fv == nil {
+			fb.AppendNull()
+			continue
+		}
+		if err := sb.appendValue(fb, fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lessMapKey(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	default:
+		return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+	}
+}
+
+// widenValue returns v as an interface{} that can be appended.
+// Pointers and interfaces are dereferenced with nil becoming a null value.
+// int and uint are widened to int64 and uint64 the same way
+// they are when building a column from memory and time.Time is
+// converted to a nanosecond Timestamp.
+func widenValue(v reflect.Value) interface{} {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+	case reflect.Int:
+		return v.Int()
+	case reflect.Uint:
+		return v.Uint()
+	}
+	if v.Type() == timeType {
+		return arrow.Timestamp(v.Interface().(time.Time).UnixNano())
+	}
+	return v.Interface()
+}
+
 // If the type of v is a pointer return the pointer as a value,
 // otherwise create a new pointer to the value.
 // func reflectValueOfNonPointer(v interface{}) reflect.Value {
@@ -10,6 +10,7 @@ import (
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/gomem/gomem/pkg/logical"
 	"github.com/gomem/gomem/pkg/metadata"
 )
 
@@ -228,3 +229,79 @@ func addListOfStructs(fi int, t *testing.T, recordBuilder *array.RecordBuilder,
 		}
 	}
 }
+
+func TestSmartBuilderDictionary(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{
+				Name:     "col-dict",
+				Type:     metadata.DictionaryIndexType,
+				Nullable: true,
+				Metadata: metadata.AppendDictionaryTypeMetadata(arrow.Metadata{}, []string{"blue", "green", "red"}),
+			},
+		},
+		nil,
+	)
+
+	recordBuilder := array.NewRecordBuilder(pool, schema)
+	defer recordBuilder.Release()
+
+	smartBuilder := NewSmartBuilder(recordBuilder)
+	for _, v := range []interface{}{"red", nil, int32(1), "blue"} {
+		if err := smartBuilder.Append(0, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := smartBuilder.Append(0, "purple"); err == nil {
+		t.Fatal("expected an error appending a value not in the dictionary")
+	}
+	if err := smartBuilder.Append(0, int32(3)); err == nil {
+		t.Fatal("expected an error appending an index out of range")
+	}
+
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+
+	if got, want := fmt.Sprintf("%v", rec.Column(0)), "[2 (null) 1 0]"; got != want {
+		t.Fatalf("got=%s, want=%s", got, want)
+	}
+}
+
+func TestSmartBuilderGoMaps(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			logical.MapField("col-map", arrow.BinaryTypes.String, arrow.PrimitiveTypes.Float64),
+		},
+		nil,
+	)
+
+	recordBuilder := array.NewRecordBuilder(pool, schema)
+	defer recordBuilder.Release()
+
+	smartBuilder := NewSmartBuilder(recordBuilder)
+	data := []interface{}{
+		map[string]float64{"field_c": 0, "field_a": 1, "field_b": 2},
+		nil,
+		map[string]float64(nil),
+		map[string]interface{}{"field_a": float64(3), "field_b": nil},
+	}
+	for _, v := range data {
+		if err := smartBuilder.Append(0, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rec := recordBuilder.NewRecord()
+	defer rec.Release()
+
+	want := `[{["field_a" "field_b" "field_c"] [1 2 0]} (null) (null) {["field_a" "field_b"] [3 (null)]}]`
+	if got := fmt.Sprintf("%v", rec.Column(0)); got != want {
+		t.Fatalf("\ngot=\n%s\nwant=\n%s", got, want)
+	}
+}